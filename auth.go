@@ -0,0 +1,44 @@
+package discogs
+
+import "context"
+
+// tokenKey is a context key of a personal access token to send a request with.
+type tokenKey struct{}
+
+// WithToken returns a context which makes calls authenticate with token,
+// instead of Options.Token or Options.OAuth credentials.
+// Use it to act on behalf of several users with a single client.
+// Calls with an empty token return ErrInvalidToken.
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey{}, token)
+}
+
+// WithOAuthToken returns a context which makes calls signed with the access token of a user,
+// instead of the one set in Options.OAuth. The client must be created with Options.OAuth
+// carrying consumer credentials, calls return ErrOAuthRequired otherwise.
+// Calls with a nil token return ErrInvalidOAuthToken.
+func WithOAuthToken(ctx context.Context, token *OAuthToken) context.Context {
+	var p *oauthParams
+	if token != nil {
+		p = &oauthParams{token: token.Token, secret: token.Secret}
+	}
+	return context.WithValue(ctx, oauthParamsKey{}, p)
+}
+
+// contextToken returns a token set by WithToken.
+func contextToken(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(tokenKey{}).(string)
+	return token, ok
+}
+
+// noCredentialsKey is a context key of requests sent without credentials,
+// e.g. to hosts other than discogs.
+type noCredentialsKey struct{}
+
+func withoutCredentials(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCredentialsKey{}, true)
+}
+
+func hasCredentials(ctx context.Context) bool {
+	return ctx.Value(noCredentialsKey{}) == nil
+}
@@ -0,0 +1,60 @@
+package discogs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithToken(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Discogs token=user-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, releaseJson)
+	}))
+	defer ts.Close()
+
+	ctx := WithToken(context.Background(), "user-token")
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL, Token: "client-token"})
+	if _, err := d.Release(ctx, 8138518); err != nil {
+		t.Fatalf("failed to get release with token override: %s", err)
+	}
+	if _, err := d.Release(context.Background(), 8138518); err == nil {
+		t.Fatal("request without token override is sent with the override")
+	}
+	if _, err := d.Release(WithToken(context.Background(), ""), 8138518); err != ErrInvalidToken {
+		t.Fatalf("err got=%v; want=%s", err, ErrInvalidToken)
+	}
+
+	d = initDiscogsClient(t, &Options{URL: ts.URL, OAuth: &OAuth{ConsumerKey: "key", ConsumerSecret: "secret"}})
+	if _, err := d.Release(ctx, 8138518); err != nil {
+		t.Fatalf("failed to get release with token override of oauth client: %s", err)
+	}
+}
+
+func TestWithOAuthToken(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(OAuthServer))
+	defer ts.Close()
+
+	ctx := WithOAuthToken(context.Background(), &OAuthToken{Token: "access-token", Secret: "access-secret"})
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL, OAuth: &OAuth{ConsumerKey: "key", ConsumerSecret: "secret"}})
+	if _, err := d.Release(ctx, 8138518); err != nil {
+		t.Fatalf("failed to get release: %s", err)
+	}
+
+	if _, err := d.Release(WithOAuthToken(context.Background(), nil), 8138518); err != ErrInvalidOAuthToken {
+		t.Fatalf("err got=%v; want=%s", err, ErrInvalidOAuthToken)
+	}
+
+	d = initDiscogsClient(t, &Options{URL: ts.URL})
+	if _, err := d.Release(ctx, 8138518); err != ErrOAuthRequired {
+		t.Fatalf("err got=%v; want=%s", err, ErrOAuthRequired)
+	}
+}
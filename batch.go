@@ -0,0 +1,106 @@
+package discogs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// BatchService is an interface to fetch many resources concurrently.
+// Requests share the client's rate limiter, so enable Options.Throttle
+// to wait for the rate limit instead of failing with ErrTooManyRequests.
+type BatchService interface {
+	// BatchReleases fetches releases by IDs with up to concurrency requests in flight.
+	// Releases are returned in order of IDs. If some requests fail, releases
+	// fetched successfully are returned with a *BatchError, failed ones are nil.
+	BatchReleases(ctx context.Context, releaseIDs []int, concurrency int, opts ...CallOption) ([]*Release, error)
+}
+
+type batchService struct {
+	database DatabaseService
+}
+
+func newBatchService(database DatabaseService) BatchService {
+	return &batchService{database: database}
+}
+
+// BatchError reports requests of a batch that failed.
+type BatchError struct {
+	// Errors are errors of failed requests in order of IDs.
+	Errors []BatchItemError
+}
+
+// BatchItemError is an error of a single request of a batch.
+type BatchItemError struct {
+	Index int // index of the ID in IDs passed to the batch
+	ID    int
+	Err   error
+}
+
+func (e *BatchError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, item := range e.Errors {
+		msgs = append(msgs, fmt.Sprintf("%d: %s", item.ID, item.Err))
+	}
+	return fmt.Sprintf("discogs error: %d requests failed: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+func (s *batchService) BatchReleases(ctx context.Context, releaseIDs []int, concurrency int, opts ...CallOption) ([]*Release, error) {
+	releases := make([]*Release, len(releaseIDs))
+	err := batch(ctx, releaseIDs, concurrency, func(ctx context.Context, i int) error {
+		release, err := s.database.Release(ctx, releaseIDs[i], opts...)
+		releases[i] = release
+		return err
+	})
+	return releases, err
+}
+
+// batch calls fetch for every index of ids with up to concurrency calls at a time.
+// It stops starting new calls once ctx is done.
+func batch(ctx context.Context, ids []int, concurrency int, fetch func(ctx context.Context, i int) error) error {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	// workers would write raw responses to the same message concurrently
+	ctx = withoutRawResponse(ctx)
+
+	var (
+		mu    sync.Mutex
+		errs  []BatchItemError
+		wg    sync.WaitGroup
+		queue = make(chan int)
+	)
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				if err := fetch(ctx, i); err != nil {
+					mu.Lock()
+					errs = append(errs, BatchItemError{Index: i, ID: ids[i], Err: err})
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i := range ids {
+		select {
+		case queue <- i:
+		case <-ctx.Done():
+			mu.Lock()
+			errs = append(errs, BatchItemError{Index: i, ID: ids[i], Err: ctx.Err()})
+			mu.Unlock()
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if len(errs) > 0 {
+		sort.Slice(errs, func(a, b int) bool { return errs[a].Index < errs[b].Index })
+		return &BatchError{Errors: errs}
+	}
+	return nil
+}
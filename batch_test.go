@@ -0,0 +1,89 @@
+package discogs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBatchServiceBatchReleases(t *testing.T) {
+	var inFlight, maxInFlight int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		if r.URL.Path == "/releases/404" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"message": "Release not found."}`)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, releaseJson)
+	}))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+	ids := []int{8138518, 404, 8138518, 404, 8138518}
+
+	releases, err := d.BatchReleases(context.Background(), ids, 2)
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("err got=%v; want *BatchError", err)
+	}
+	if len(batchErr.Errors) != 2 {
+		t.Fatalf("batch errors got=%v; want both 404", batchErr.Errors)
+	}
+	for n, index := range []int{1, 3} {
+		if e := batchErr.Errors[n]; e.Index != index || e.ID != 404 || e.Err == nil {
+			t.Errorf("batch error #%d got=%+v; want index %d of 404", n, e, index)
+		}
+	}
+
+	if len(releases) != len(ids) {
+		t.Fatalf("releases got=%d; want=%d", len(releases), len(ids))
+	}
+	for i, release := range releases {
+		if ids[i] == 404 {
+			if release != nil {
+				t.Errorf("#%d release got=%v; want nil", i, release)
+			}
+			continue
+		}
+		if release == nil || release.ID != 8138518 {
+			t.Errorf("#%d release got=%v; want 8138518", i, release)
+		}
+	}
+
+	if got := atomic.LoadInt32(&maxInFlight); got > 2 {
+		t.Errorf("requests in flight got=%d; want at most 2", got)
+	}
+}
+
+func TestBatchServiceBatchReleasesCanceled(t *testing.T) {
+	d := initDiscogsClient(t, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := d.BatchReleases(ctx, []int{1, 2}, 1)
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) || len(batchErr.Errors) != 2 {
+		t.Fatalf("err got=%v; want both requests canceled", err)
+	}
+	for _, e := range batchErr.Errors {
+		if !errors.Is(e.Err, context.Canceled) {
+			t.Errorf("%d err got=%v; want=%s", e.ID, e.Err, context.Canceled)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package discogs
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sync"
+)
+
+// Cache stores bodies of GET responses to revalidate them with ETags.
+// A cached body is sent If-None-Match and served locally on 304 Not Modified.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns a response stored by key.
+	Get(key string) (CachedResponse, bool)
+	// Set stores a response by key.
+	Set(key string, response CachedResponse)
+}
+
+// CachedResponse is a response body stored with its ETag.
+type CachedResponse struct {
+	ETag string
+	Body []byte
+}
+
+// NewMemoryCache returns a Cache keeping up to size responses in memory.
+// The least recently used response is evicted once the cache is full.
+// If size is 0 or less, the cache is unbounded.
+func NewMemoryCache(size int) Cache {
+	return &memoryCache{
+		size:  size,
+		items: map[string]*list.Element{},
+		order: list.New(),
+	}
+}
+
+type memoryCache struct {
+	mu    sync.Mutex
+	size  int
+	items map[string]*list.Element
+	// order keeps entries from the most to the least recently used.
+	order *list.List
+}
+
+type memoryCacheEntry struct {
+	key      string
+	response CachedResponse
+}
+
+func (c *memoryCache) Get(key string) (CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return CachedResponse{}, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*memoryCacheEntry).response, true
+}
+
+func (c *memoryCache) Set(key string, response CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		e.Value.(*memoryCacheEntry).response = response
+		c.order.MoveToFront(e)
+		return
+	}
+	c.items[key] = c.order.PushFront(&memoryCacheEntry{key: key, response: response})
+	if c.size > 0 && c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// cacheKey returns a key of the request in the cache.
+// Responses depend on credentials and media type, so they are a part of the key,
+// credentials are hashed.
+func (c *client) cacheKey(r *http.Request) string {
+	// signatures differ per request, so requests signed with OAuth are keyed by the token
+	credentials := r.Header.Get("Authorization")
+	if _, ok := contextToken(r.Context()); !ok && c.oauth != nil {
+		token := c.oauth.Token
+		if p, ok := r.Context().Value(oauthParamsKey{}).(*oauthParams); ok && p != nil {
+			token = p.token
+		}
+		credentials = c.oauth.ConsumerKey + "&" + token
+	}
+	sum := sha256.Sum256([]byte(credentials))
+	return r.Method + " " + r.URL.String() + " " + r.Header.Get("Accept") + " " + hex.EncodeToString(sum[:])
+}
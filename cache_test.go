@@ -0,0 +1,91 @@
+package discogs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCacheRevalidatesWithETag(t *testing.T) {
+	var full, notModified int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"folder"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		full++
+		w.Header().Set("ETag", `"folder"`)
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, folderJson)
+	}))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL, Cache: NewMemoryCache(0)})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		folder, err := d.Folder(ctx, testUsername, 0)
+		if err != nil {
+			t.Fatalf("failed to get folder: %s", err)
+		}
+		if folder.Name != "All" {
+			t.Fatalf("folder name got=%s; want=All", folder.Name)
+		}
+	}
+	if full != 1 || notModified != 2 {
+		t.Errorf("responses got full=%d, not modified=%d; want full=1, not modified=2", full, notModified)
+	}
+}
+
+func TestCacheNotModifiedWithoutCache(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+	if _, err := d.Folder(context.Background(), testUsername, 0); err == nil {
+		t.Fatal("expected error for unexpected 304")
+	}
+}
+
+func TestMemoryCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewMemoryCache(2)
+	c.Set("a", CachedResponse{ETag: "a"})
+	c.Set("b", CachedResponse{ETag: "b"})
+	c.Get("a")
+	c.Set("c", CachedResponse{ETag: "c"})
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("b is not evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if r, ok := c.Get(key); !ok || r.ETag != key {
+			t.Errorf("%s got=%+v, %t", key, r, ok)
+		}
+	}
+}
+
+func TestCacheKeyDependsOnCredentials(t *testing.T) {
+	c := &client{}
+	r1, _ := http.NewRequest("GET", "https://api.discogs.com/users/test_user", nil)
+	r2 := r1.Clone(context.Background())
+	r2.Header.Set("Authorization", "Discogs token=secret")
+
+	if c.cacheKey(r1) == c.cacheKey(r2) {
+		t.Error("cache keys of requests with different credentials are equal")
+	}
+}
+
+func TestCacheKeyDependsOnOAuthTokenOverride(t *testing.T) {
+	c := &client{oauth: &OAuth{ConsumerKey: "key", Token: "client-token"}}
+	r1, _ := http.NewRequest("GET", "https://api.discogs.com/users/test_user", nil)
+	r2 := r1.WithContext(WithOAuthToken(context.Background(), &OAuthToken{Token: "user-token"}))
+
+	if c.cacheKey(r1) == c.cacheKey(r2) {
+		t.Error("cache keys of requests signed with different tokens are equal")
+	}
+}
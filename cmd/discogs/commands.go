@@ -0,0 +1,373 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hrfee/go-discogs"
+)
+
+// errLimit stops iterating over search results once enough are printed.
+var errLimit = errors.New("limit reached")
+
+func runSearch(ctx context.Context, e *env, args []string) error {
+	fs := e.flags("search")
+	typ := fs.String("type", "", "type of results: release, master, artist or label")
+	limit := fs.Int("n", 50, "maximum number of results")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("query is required")
+	}
+
+	req := discogs.SearchRequest{Q: strings.Join(fs.Args(), " "), Type: discogs.SearchType(*typ)}
+	if *limit < 100 {
+		req.PerPage = *limit
+	}
+	var results []discogs.Result
+	err := e.client.SearchAll(ctx, req, func(r discogs.Result) error {
+		results = append(results, r)
+		// stopping on the last result wanted saves fetching a page for nothing
+		if len(results) >= *limit {
+			return errLimit
+		}
+		return nil
+	})
+	if err != nil && err != errLimit {
+		return err
+	}
+
+	var rows [][]string
+	for _, r := range results {
+		var (
+			base discogs.ResultBase
+			year string
+		)
+		switch {
+		case r.Release != nil:
+			base, year = r.Release.ResultBase, r.Release.Year
+		case r.Master != nil:
+			base, year = r.Master.ResultBase, r.Master.Year
+		case r.Artist != nil:
+			base = r.Artist.ResultBase
+		case r.Label != nil:
+			base = r.Label.ResultBase
+		}
+		rows = append(rows, []string{string(r.Type), strconv.Itoa(base.ID), base.Title, year})
+	}
+	return e.out.print(results, []string{"TYPE", "ID", "TITLE", "YEAR"}, rows)
+}
+
+func runRelease(ctx context.Context, e *env, args []string) error {
+	id, err := idArg(e, "release", args)
+	if err != nil {
+		return err
+	}
+	r, err := e.client.Release(ctx, id)
+	if err != nil {
+		return err
+	}
+	var labels, formats []string
+	for _, l := range r.Labels {
+		labels = append(labels, l.Name+" – "+l.Catno)
+	}
+	for _, f := range r.Formats {
+		formats = append(formats, strings.Join(append([]string{f.Name}, f.Descriptions...), ", "))
+	}
+	return e.out.fields(r,
+		"ID", strconv.Itoa(r.ID),
+		"Title", r.Title,
+		"Artists", artistNames(r.Artists),
+		"Labels", strings.Join(labels, "; "),
+		"Formats", strings.Join(formats, "; "),
+		"Country", r.Country,
+		"Released", r.Released,
+		"Genres", strings.Join(r.Genres, ", "),
+		"Styles", strings.Join(r.Styles, ", "),
+		"Master", strconv.Itoa(r.MasterID),
+		"URI", r.URI,
+	)
+}
+
+func runMaster(ctx context.Context, e *env, args []string) error {
+	id, err := idArg(e, "master", args)
+	if err != nil {
+		return err
+	}
+	m, err := e.client.Master(ctx, id)
+	if err != nil {
+		return err
+	}
+	return e.out.fields(m,
+		"ID", strconv.Itoa(m.ID),
+		"Title", m.Title,
+		"Artists", artistNames(m.Artists),
+		"Year", strconv.Itoa(m.Year),
+		"Genres", strings.Join(m.Genres, ", "),
+		"Styles", strings.Join(m.Styles, ", "),
+		"Main Release", strconv.Itoa(m.MainRelease),
+	)
+}
+
+func runArtist(ctx context.Context, e *env, args []string) error {
+	id, err := idArg(e, "artist", args)
+	if err != nil {
+		return err
+	}
+	a, err := e.client.Artist(ctx, id)
+	if err != nil {
+		return err
+	}
+	return e.out.fields(a,
+		"ID", strconv.Itoa(a.ID),
+		"Name", a.Name,
+		"Real Name", a.Realname,
+		"Profile", a.Profile,
+		"URLs", strings.Join(a.URLs, " "),
+	)
+}
+
+func runLabel(ctx context.Context, e *env, args []string) error {
+	id, err := idArg(e, "label", args)
+	if err != nil {
+		return err
+	}
+	l, err := e.client.Label(ctx, id)
+	if err != nil {
+		return err
+	}
+	return e.out.fields(l,
+		"ID", strconv.Itoa(l.ID),
+		"Name", l.Name,
+		"Profile", l.Profile,
+		"Contact Info", l.ContactInfo,
+		"URLs", strings.Join(l.URLs, " "),
+	)
+}
+
+func runCollection(ctx context.Context, e *env, args []string) error {
+	verb, args, err := verbArg(e, "collection", args, "list", "export")
+	if err != nil {
+		return err
+	}
+	fs := e.flags("collection " + verb)
+	folder := fs.Int("folder", 0, "folder id, 0 is all releases")
+	format := fs.String("format", "csv", "export format: csv or json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errors.New("username is required")
+	}
+	username := fs.Arg(0)
+
+	if verb == "export" {
+		return e.client.ExportCollection(ctx, username, *folder, e.out.w, discogs.ExportFormat(*format))
+	}
+
+	var (
+		items []discogs.CollectionItemSource
+		rows  [][]string
+	)
+	pager := e.client.CollectionItemsByFolderPager(username, *folder, &discogs.Pagination{PerPage: 100})
+	err = pager.ForEach(ctx, func(item discogs.CollectionItemSource) error {
+		items = append(items, item)
+		info := item.BasicInformation
+		rows = append(rows, []string{
+			strconv.Itoa(item.ID),
+			strconv.Itoa(item.InstanceID),
+			artistNames(info.Artists),
+			info.Title,
+			year(info.Year),
+			strconv.Itoa(item.Rating),
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return e.out.print(items, []string{"ID", "INSTANCE", "ARTIST", "TITLE", "YEAR", "RATING"}, rows)
+}
+
+func runWantlist(ctx context.Context, e *env, args []string) error {
+	verb, args, err := verbArg(e, "wantlist", args, "list", "add", "remove")
+	if err != nil {
+		return err
+	}
+	fs := e.flags("wantlist " + verb)
+	notes := fs.String("notes", "", "notes of the want (add only)")
+	rating := fs.Int("rating", 0, "rating between 0 and 5 (add only)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if verb == "list" {
+		if fs.NArg() != 1 {
+			fs.Usage()
+			return errors.New("username is required")
+		}
+		var (
+			wants []discogs.Want
+			rows  [][]string
+		)
+		err := e.client.WantlistPager(fs.Arg(0), &discogs.Pagination{PerPage: 100}).ForEach(ctx, func(w discogs.Want) error {
+			wants = append(wants, w)
+			info := w.BasicInformation
+			rows = append(rows, []string{strconv.Itoa(w.ID), artistNames(info.Artists), info.Title, year(info.Year), w.Notes})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return e.out.print(wants, []string{"ID", "ARTIST", "TITLE", "YEAR", "NOTES"}, rows)
+	}
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return errors.New("username and release id are required")
+	}
+	username := fs.Arg(0)
+	releaseID, err := strconv.Atoi(fs.Arg(1))
+	if err != nil {
+		return fmt.Errorf("invalid release id %q", fs.Arg(1))
+	}
+	if verb == "remove" {
+		return e.client.DeleteFromWantlist(ctx, username, releaseID)
+	}
+	w, err := e.client.AddToWantlist(ctx, username, releaseID, &discogs.WantRequest{Notes: *notes, Rating: *rating})
+	if err != nil {
+		return err
+	}
+	return e.out.fields(w,
+		"ID", strconv.Itoa(w.ID),
+		"Title", w.BasicInformation.Title,
+		"Notes", w.Notes,
+	)
+}
+
+func runListing(ctx context.Context, e *env, args []string) error {
+	verb, args, err := verbArg(e, "listing", args, "stats", "create", "edit", "delete")
+	if err != nil {
+		return err
+	}
+	fs := e.flags("listing " + verb)
+	var listing discogs.ListingRequest
+	fs.IntVar(&listing.ReleaseID, "release", 0, "release of the listing (create and edit)")
+	condition := fs.String("condition", "", "media condition, e.g. \"Near Mint (NM or M-)\" (create and edit)")
+	sleeve := fs.String("sleeve", "", "sleeve condition")
+	fs.Float64Var(&listing.Price, "price", 0, "price in the seller's currency (create and edit)")
+	fs.StringVar(&listing.Comments, "comments", "", "remarks about the item")
+	fs.BoolVar(&listing.AllowOffers, "offers", false, "allow offers")
+	draft := fs.Bool("draft", false, "save the listing as a draft instead of listing it for sale")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if verb == "create" || verb == "edit" {
+		// edit replaces the whole listing, so defaults must not overwrite its fields
+		set := map[string]bool{}
+		fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+		for _, name := range []string{"release", "price", "condition"} {
+			if !set[name] {
+				fs.Usage()
+				return fmt.Errorf("-%s is required to %s a listing", name, verb)
+			}
+		}
+	}
+	listing.Condition = discogs.Condition(*condition)
+	listing.SleeveCondition = discogs.Condition(*sleeve)
+	listing.Status = discogs.ListingForSale
+	if *draft {
+		listing.Status = discogs.ListingDraft
+	}
+
+	switch verb {
+	case "stats":
+		id, err := idArg(e, "listing stats", fs.Args())
+		if err != nil {
+			return err
+		}
+		stats, err := e.client.ReleaseStatistics(ctx, id)
+		if err != nil {
+			return err
+		}
+		lowest := ""
+		if stats.LowestPrice != nil {
+			lowest = strconv.FormatFloat(stats.LowestPrice.Value, 'f', 2, 64) + " " + stats.LowestPrice.Currency
+		}
+		return e.out.fields(stats,
+			"For Sale", strconv.Itoa(stats.ForSale),
+			"Lowest Price", lowest,
+			"Blocked", strconv.FormatBool(stats.Blocked),
+		)
+	case "create":
+		if fs.NArg() != 0 {
+			fs.Usage()
+			return errors.New("create takes no arguments, set the release with -release")
+		}
+		created, err := e.client.CreateListing(ctx, &listing)
+		if err != nil {
+			return err
+		}
+		return e.out.fields(created, "Listing ID", strconv.Itoa(created.ListingID), "URL", created.ResourceURL)
+	case "edit":
+		id, err := idArg(e, "listing edit", fs.Args())
+		if err != nil {
+			return err
+		}
+		return e.client.EditListing(ctx, id, &listing)
+	default:
+		id, err := idArg(e, "listing delete", fs.Args())
+		if err != nil {
+			return err
+		}
+		return e.client.DeleteListing(ctx, id)
+	}
+}
+
+// idArg returns the only argument of a command as an id.
+func idArg(e *env, name string, args []string) (int, error) {
+	if len(args) != 1 {
+		e.flags(name).Usage()
+		return 0, errors.New("id is required")
+	}
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return 0, fmt.Errorf("invalid id %q", args[0])
+	}
+	return id, nil
+}
+
+// verbArg splits the verb of a command, like list in "collection list", from its arguments.
+func verbArg(e *env, name string, args []string, verbs ...string) (string, []string, error) {
+	if len(args) > 0 {
+		for _, v := range verbs {
+			if args[0] == v {
+				return v, args[1:], nil
+			}
+		}
+	}
+	e.flags(name).Usage()
+	return "", nil, fmt.Errorf("%s requires one of: %s", name, strings.Join(verbs, ", "))
+}
+
+func artistNames(artists []discogs.ArtistSource) string {
+	var names []string
+	for _, a := range artists {
+		names = append(names, a.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func year(y int) string {
+	if y == 0 {
+		return ""
+	}
+	return strconv.Itoa(y)
+}
@@ -0,0 +1,131 @@
+// Command discogs is a command line client of the discogs API.
+//
+// Usage:
+//
+//	discogs [flags] <command> [arguments]
+//
+// Commands are search, release, master, artist, label, collection, wantlist and listing,
+// run a command with -h to see its arguments. Results are printed as a table,
+// or as JSON with -json. A personal access token is read from -token or DISCOGS_TOKEN.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"github.com/hrfee/go-discogs"
+)
+
+const userAgent = "go-discogs-cli"
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "discogs:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// env is what commands run with.
+type env struct {
+	client discogs.Discogs
+	out    *printer
+	stderr io.Writer
+}
+
+// commands are subcommands of the CLI.
+var commands = map[string]func(ctx context.Context, e *env, args []string) error{
+	"search":     runSearch,
+	"release":    runRelease,
+	"master":     runMaster,
+	"artist":     runArtist,
+	"label":      runLabel,
+	"collection": runCollection,
+	"wantlist":   runWantlist,
+	"listing":    runListing,
+}
+
+// usages are one-line usages of commands.
+var usages = map[string]string{
+	"search":     "search [-type release|master|artist|label] [-n limit] <query>",
+	"release":    "release <id>",
+	"master":     "master <id>",
+	"artist":     "artist <id>",
+	"label":      "label <id>",
+	"collection": "collection list|export [-folder id] [-format csv|json] <username>",
+	"wantlist":   "wantlist list|add|remove [-notes notes] [-rating n] <username> [release id]",
+	"listing":    "listing stats|create|edit|delete [-release id -price n -condition c] [release or listing id]",
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("discogs", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	token := fs.String("token", "", "personal access token (default $DISCOGS_TOKEN)")
+	currency := fs.String("currency", "", "currency of marketplace prices (default USD)")
+	apiURL := fs.String("url", "", "discogs API endpoint (default https://api.discogs.com)")
+	asJSON := fs.Bool("json", false, "print results as JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: discogs [flags] <command> [arguments]")
+		fmt.Fprintln(stderr, "\ncommands:")
+		names := make([]string, 0, len(usages))
+		for name := range usages {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintln(stderr, "  "+usages[name])
+		}
+		fmt.Fprintln(stderr, "\nflags:")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+	if *token == "" {
+		*token = os.Getenv("DISCOGS_TOKEN")
+	}
+	runCommand, ok := commands[fs.Arg(0)]
+	if !ok {
+		return fmt.Errorf("unknown command %q", fs.Arg(0))
+	}
+
+	client, err := discogs.New(&discogs.Options{
+		UserAgent: userAgent,
+		Token:     *token,
+		Currency:  discogs.Currency(strings.ToUpper(*currency)),
+		URL:       *apiURL,
+		Throttle:  true,
+		Retry:     &discogs.RetryPolicy{MaxAttempts: 3},
+	})
+	if err != nil {
+		return err
+	}
+	e := &env{client: client, out: &printer{w: stdout, json: *asJSON}, stderr: stderr}
+	return runCommand(ctx, e, fs.Args()[1:])
+}
+
+// flags returns a flag set of the command, printing its usage on errors.
+func (e *env) flags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(e.stderr, "usage: discogs "+usages[strings.Fields(name)[0]])
+		fs.PrintDefaults()
+	}
+	return fs
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hrfee/go-discogs"
+	"github.com/hrfee/go-discogs/discogstest"
+)
+
+func runTest(t *testing.T, srv *discogstest.Server, args ...string) string {
+	t.Helper()
+	var stdout, stderr strings.Builder
+	if err := run(context.Background(), append([]string{"-url", srv.URL}, args...), &stdout, &stderr); err != nil {
+		t.Fatalf("%v: %s\n%s", args, err, stderr.String())
+	}
+	return stdout.String()
+}
+
+func TestRelease(t *testing.T) {
+	srv := discogstest.NewServer()
+	defer srv.Close()
+	srv.AddRelease(&discogs.Release{ID: 8138518, Title: "Stockholm", Artists: []discogs.ArtistSource{{Name: "Chvrches"}}})
+
+	out := runTest(t, srv, "release", "8138518")
+	if !strings.Contains(out, "Stockholm") || !strings.Contains(out, "Chvrches") {
+		t.Errorf("unexpected table:\n%s", out)
+	}
+
+	var release discogs.Release
+	if err := json.Unmarshal([]byte(runTest(t, srv, "-json", "release", "8138518")), &release); err != nil {
+		t.Fatalf("failed to unmarshal json output: %s", err)
+	}
+	if release.Title != "Stockholm" {
+		t.Errorf("title got=%s; want=Stockholm", release.Title)
+	}
+}
+
+func TestCollection(t *testing.T) {
+	srv := discogstest.NewServer()
+	defer srv.Close()
+	srv.AddCollectionItem("test_user", 1, discogs.CollectionItemSource{
+		ID:               12934893,
+		BasicInformation: discogs.BasicInformation{Title: "Zonk", Year: 2018},
+	})
+
+	if out := runTest(t, srv, "collection", "list", "test_user"); !strings.Contains(out, "Zonk") {
+		t.Errorf("unexpected table:\n%s", out)
+	}
+	out := runTest(t, srv, "collection", "export", "-format", "csv", "test_user")
+	if !strings.HasPrefix(out, "Catalog#,") || !strings.Contains(out, "Zonk,,,0,2018,12934893,Uncategorized") {
+		t.Errorf("unexpected export:\n%s", out)
+	}
+}
+
+func TestSearchStopsAtLimit(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		results := make([]string, perPage)
+		for i := range results {
+			results[i] = fmt.Sprintf(`{"type": "release", "id": %d, "title": "Release %d"}`, i, i)
+		}
+		next := fmt.Sprintf("http://%s/database/search?page=%d&per_page=%d", r.Host, page+1, perPage)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"pagination": {"page": %d, "pages": 2, "per_page": %d, "items": %d, "urls": {"next": %q}}, "results": [%s]}`,
+			page, perPage, 2*perPage, next, strings.Join(results, ","))
+	}))
+	defer ts.Close()
+
+	tests := map[string]struct {
+		args     []string
+		rows     int
+		requests int32
+	}{
+		"default limit": {args: []string{"search", "stockholm"}, rows: 50, requests: 1},
+		"one page":      {args: []string{"search", "-n", "10", "stockholm"}, rows: 10, requests: 1},
+	}
+	for name, tt := range tests {
+		atomic.StoreInt32(&requests, 0)
+		var stdout, stderr strings.Builder
+		if err := run(context.Background(), append([]string{"-url", ts.URL}, tt.args...), &stdout, &stderr); err != nil {
+			t.Fatalf("%s: %s\n%s", name, err, stderr.String())
+		}
+		// a header and a row per result
+		if got := strings.Count(stdout.String(), "\n") - 1; got != tt.rows {
+			t.Errorf("%s: rows got=%d; want=%d", name, got, tt.rows)
+		}
+		if got := atomic.LoadInt32(&requests); got != tt.requests {
+			t.Errorf("%s: requests got=%d; want=%d", name, got, tt.requests)
+		}
+	}
+}
+
+func TestUnknownCommand(t *testing.T) {
+	var stdout, stderr strings.Builder
+	if err := run(context.Background(), []string{"unknown"}, &stdout, &stderr); err == nil {
+		t.Error("unknown command succeeded")
+	}
+}
+
+func TestListingEditRequiresFields(t *testing.T) {
+	srv := discogstest.NewServer()
+	defer srv.Close()
+	srv.Handle("POST", "/marketplace/listings/1", http.StatusNoContent, "")
+
+	var stdout, stderr strings.Builder
+	err := run(context.Background(), []string{"-url", srv.URL, "listing", "edit", "-price", "10", "1"}, &stdout, &stderr)
+	if err == nil || !strings.Contains(err.Error(), "-release") {
+		t.Errorf("err got=%v; want -release is required", err)
+	}
+
+	runTest(t, srv, "listing", "edit", "-release", "8138518", "-price", "10", "-condition", string(discogs.ConditionMint), "1")
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// printer prints results either as JSON or as a table.
+type printer struct {
+	w    io.Writer
+	json bool
+}
+
+// print prints v as JSON, or header and rows as a table.
+func (p *printer) print(v interface{}, header []string, rows [][]string) error {
+	if p.json {
+		enc := json.NewEncoder(p.w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+	if _, err := io.WriteString(tw, strings.Join(header, "\t")+"\n"); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if _, err := io.WriteString(tw, strings.Join(row, "\t")+"\n"); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+// fields prints name-value pairs of a single resource.
+func (p *printer) fields(v interface{}, pairs ...string) error {
+	var rows [][]string
+	for i := 0; i+1 < len(pairs); i += 2 {
+		rows = append(rows, []string{pairs[i], oneLine(pairs[i+1])})
+	}
+	return p.print(v, []string{"FIELD", "VALUE"}, rows)
+}
+
+// oneLine joins lines of s, so tables of multi-line profiles stay readable.
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,79 @@
+package discogs
+
+// Currency is a currency of marketplace data.
+type Currency string
+
+// Currencies discogs accepts.
+const (
+	CurrencyUSD Currency = "USD"
+	CurrencyGBP Currency = "GBP"
+	CurrencyEUR Currency = "EUR"
+	CurrencyCAD Currency = "CAD"
+	CurrencyAUD Currency = "AUD"
+	CurrencyJPY Currency = "JPY"
+	CurrencyCHF Currency = "CHF"
+	CurrencyMXN Currency = "MXN"
+	CurrencyBRL Currency = "BRL"
+	CurrencyNZD Currency = "NZD"
+	CurrencySEK Currency = "SEK"
+	CurrencyZAR Currency = "ZAR"
+	CurrencyDKK Currency = "DKK"
+)
+
+// currencies is a list of currencies discogs accepts.
+// Options.Currencies adds ones discogs accepts but which aren't listed yet.
+var currencies = []Currency{
+	CurrencyUSD,
+	CurrencyGBP,
+	CurrencyEUR,
+	CurrencyCAD,
+	CurrencyAUD,
+	CurrencyJPY,
+	CurrencyCHF,
+	CurrencyMXN,
+	CurrencyBRL,
+	CurrencyNZD,
+	CurrencySEK,
+	CurrencyZAR,
+	CurrencyDKK,
+}
+
+// Valid reports whether the currency is one of the currencies listed above.
+func (c Currency) Valid() bool {
+	for _, cur := range currencies {
+		if c == cur {
+			return true
+		}
+	}
+	return false
+}
+
+// validCode reports whether c looks like an ISO 4217 code, e.g. NOK.
+func (c Currency) validCode() bool {
+	if len(c) != 3 {
+		return false
+	}
+	for _, r := range c {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// validCurrency validates currency for marketplace data.
+// Defaults to USD. Must be a listed currency or one of Options.Currencies.
+func (c *client) validCurrency(cur Currency) (Currency, error) {
+	if cur == "" {
+		return CurrencyUSD, nil
+	}
+	if cur.Valid() {
+		return cur, nil
+	}
+	for _, extra := range c.currencies {
+		if cur == extra {
+			return cur, nil
+		}
+	}
+	return "", ErrCurrencyNotSupported
+}
@@ -1,6 +1,7 @@
 package discogs
 
 import (
+	"context"
 	"net/url"
 	"strconv"
 )
@@ -15,30 +16,52 @@ const (
 // DatabaseService is an interface to work with database.
 type DatabaseService interface {
 	// Artist represents a person in the discogs database.
-	Artist(artistID int) (*Artist, error)
+	Artist(ctx context.Context, artistID int) (*Artist, error)
 	// ArtistReleases returns a list of releases and masters associated with the artist.
-	ArtistReleases(artistID int, pagination *Pagination) (*ArtistReleases, error)
+	// It may be sorted by year, title or format.
+	ArtistReleases(ctx context.Context, artistID int, pagination *Pagination) (*ArtistReleases, error)
+	// ArtistReleasesPager iterates over all pages of artist releases.
+	ArtistReleasesPager(artistID int, pagination *Pagination) *Pager[ReleaseSource]
 	// Label returns a label.
-	Label(labelID int) (*Label, error)
+	Label(ctx context.Context, labelID int) (*Label, error)
 	// LabelReleases returns a list of Releases associated with the label.
-	LabelReleases(labelID int, pagination *Pagination) (*LabelReleases, error)
+	// It may be sorted by year, title or format.
+	LabelReleases(ctx context.Context, labelID int, pagination *Pagination) (*LabelReleases, error)
+	// LabelReleasesPager iterates over all pages of label releases.
+	LabelReleasesPager(labelID int, pagination *Pagination) *Pager[ReleaseSource]
 	// Master returns a master release.
-	Master(masterID int) (*Master, error)
+	Master(ctx context.Context, masterID int) (*Master, error)
 	// MasterVersions retrieves a list of all Releases that are versions of this master.
-	MasterVersions(masterID int, pagination *Pagination) (*MasterVersions, error)
+	// It accepts filters, sorting and pagination parameters.
+	MasterVersions(ctx context.Context, masterID int, req *MasterVersionsRequest) (*MasterVersions, error)
+	// MasterVersionsPager iterates over all pages of master versions.
+	MasterVersionsPager(masterID int, req *MasterVersionsRequest) *Pager[Version]
 	// Release returns release by release's ID.
-	Release(releaseID int) (*Release, error)
+	// Prices are in the client's currency unless WithCurrency is passed.
+	Release(ctx context.Context, releaseID int, opts ...CallOption) (*Release, error)
 	// ReleaseRating retruns community release rating.
-	ReleaseRating(releaseID int) (*ReleaseRating, error)
+	ReleaseRating(ctx context.Context, releaseID int) (*ReleaseRating, error)
+	// ReleaseStats returns the number of users who have the release in their collection or wantlist.
+	ReleaseStats(ctx context.Context, releaseID int) (*ReleaseStats, error)
+	// ReleaseRatingByUser returns the rating of a release made by the user.
+	ReleaseRatingByUser(ctx context.Context, releaseID int, username string) (*UserReleaseRating, error)
+	// UpdateReleaseRating adds or changes the rating of a release made by the user.
+	// The rating must be between 1 and 5. Authentication as the user is required.
+	UpdateReleaseRating(ctx context.Context, releaseID int, username string, rating int) (*UserReleaseRating, error)
+	// DeleteReleaseRating deletes the rating of a release made by the user.
+	// Authentication as the user is required.
+	DeleteReleaseRating(ctx context.Context, releaseID int, username string) error
 }
 
 type databaseService struct {
+	*client
 	url      string
-	currency string
+	currency Currency
 }
 
-func newDatabaseService(url string, currency string) DatabaseService {
+func newDatabaseService(c *client, url string, currency Currency) DatabaseService {
 	return &databaseService{
+		client:   c,
 		url:      url,
 		currency: currency,
 	}
@@ -82,12 +105,19 @@ type Release struct {
 	Year              int            `json:"year"`
 }
 
-func (s *databaseService) Release(releaseID int) (*Release, error) {
+func (s *databaseService) Release(ctx context.Context, releaseID int, opts ...CallOption) (*Release, error) {
+	if releaseID == 0 {
+		return nil, ErrInvalidReleaseID
+	}
+	cur, err := s.callCurrency(s.currency, opts)
+	if err != nil {
+		return nil, err
+	}
 	params := url.Values{}
-	params.Set("curr_abbr", s.currency)
+	params.Set("curr_abbr", string(cur))
 
 	var release *Release
-	err := request(s.url+releasesURI+strconv.Itoa(releaseID), params, &release)
+	err = s.request(ctx, s.url+releasesURI+strconv.Itoa(releaseID), params, &release)
 	return release, err
 }
 
@@ -97,12 +127,78 @@ type ReleaseRating struct {
 	Rating Rating `json:"rating"`
 }
 
-func (s *databaseService) ReleaseRating(releaseID int) (*ReleaseRating, error) {
+func (s *databaseService) ReleaseRating(ctx context.Context, releaseID int) (*ReleaseRating, error) {
+	if releaseID == 0 {
+		return nil, ErrInvalidReleaseID
+	}
 	var rating *ReleaseRating
-	err := request(s.url+releasesURI+strconv.Itoa(releaseID)+"/rating", nil, &rating)
+	err := s.request(ctx, s.url+releasesURI+strconv.Itoa(releaseID)+"/rating", nil, &rating)
 	return rating, err
 }
 
+// ReleaseStats serves response for release stats request.
+type ReleaseStats struct {
+	NumHave int `json:"num_have"`
+	NumWant int `json:"num_want"`
+}
+
+func (s *databaseService) ReleaseStats(ctx context.Context, releaseID int) (*ReleaseStats, error) {
+	if releaseID == 0 {
+		return nil, ErrInvalidReleaseID
+	}
+	var stats *ReleaseStats
+	err := s.request(ctx, s.url+releasesURI+strconv.Itoa(releaseID)+"/stats", nil, &stats)
+	return stats, err
+}
+
+// UserReleaseRating serves response for release rating made by a user.
+type UserReleaseRating struct {
+	Username string `json:"username"`
+	ID       int    `json:"release_id"`
+	Rating   int    `json:"rating"`
+}
+
+func (s *databaseService) ReleaseRatingByUser(ctx context.Context, releaseID int, username string) (*UserReleaseRating, error) {
+	path, err := s.userRatingPath(releaseID, username)
+	if err != nil {
+		return nil, err
+	}
+	var rating *UserReleaseRating
+	err = s.request(ctx, path, nil, &rating)
+	return rating, err
+}
+
+func (s *databaseService) UpdateReleaseRating(ctx context.Context, releaseID int, username string, rating int) (*UserReleaseRating, error) {
+	path, err := s.userRatingPath(releaseID, username)
+	if err != nil {
+		return nil, err
+	}
+	if rating < 1 || rating > 5 {
+		return nil, ErrInvalidRating
+	}
+	var r *UserReleaseRating
+	err = s.requestWithJSONBody(ctx, "PUT", path, nil, map[string]int{"rating": rating}, &r)
+	return r, err
+}
+
+func (s *databaseService) DeleteReleaseRating(ctx context.Context, releaseID int, username string) error {
+	path, err := s.userRatingPath(releaseID, username)
+	if err != nil {
+		return err
+	}
+	return s.requestWithMethod(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *databaseService) userRatingPath(releaseID int, username string) (string, error) {
+	if releaseID == 0 {
+		return "", ErrInvalidReleaseID
+	}
+	if username == "" {
+		return "", ErrInvalidUsername
+	}
+	return s.url + releasesURI + strconv.Itoa(releaseID) + "/rating/" + username, nil
+}
+
 // Artist resource represents a person in the Discogs database
 // who contributed to a Release in some capacity.
 // More information https://www.discogs.com/developers#page:database,header:database-artist
@@ -123,9 +219,9 @@ type Artist struct {
 	DataQuality    string   `json:"data_quality"`
 }
 
-func (s *databaseService) Artist(artistID int) (*Artist, error) {
+func (s *databaseService) Artist(ctx context.Context, artistID int) (*Artist, error) {
 	var artist *Artist
-	err := request(s.url+artistsURI+strconv.Itoa(artistID), nil, &artist)
+	err := s.request(ctx, s.url+artistsURI+strconv.Itoa(artistID), nil, &artist)
 	return artist, err
 }
 
@@ -135,12 +231,30 @@ type ArtistReleases struct {
 	Releases   []ReleaseSource `json:"releases"`
 }
 
-func (s *databaseService) ArtistReleases(artistID int, pagination *Pagination) (*ArtistReleases, error) {
+// valid sort keys of artist and label releases
+// https://www.discogs.com/developers#page:database,header:database-artist-releases
+var validReleasesSort = newSortKeys(
+	SortByYear,
+	SortByTitle,
+	SortByFormat,
+)
+
+func (s *databaseService) ArtistReleases(ctx context.Context, artistID int, pagination *Pagination) (*ArtistReleases, error) {
+	if err := pagination.validate(validReleasesSort); err != nil {
+		return nil, err
+	}
 	var releases *ArtistReleases
-	err := request(s.url+artistsURI+strconv.Itoa(artistID)+"/releases", pagination.params(), &releases)
+	err := s.request(ctx, s.url+artistsURI+strconv.Itoa(artistID)+"/releases", pagination.params(), &releases)
 	return releases, err
 }
 
+func (s *databaseService) ArtistReleasesPager(artistID int, pagination *Pagination) *Pager[ReleaseSource] {
+	if err := pagination.validate(validReleasesSort); err != nil {
+		return errPager[ReleaseSource](err)
+	}
+	return newPager[ReleaseSource](s.client, s.url+artistsURI+strconv.Itoa(artistID)+"/releases", pagination.params(), "releases")
+}
+
 // Label resource represents a label, company, recording studio, location,
 // or other entity involved with artists and releases.
 type Label struct {
@@ -157,9 +271,9 @@ type Label struct {
 	DataQuality string     `json:"data_quality"`
 }
 
-func (s *databaseService) Label(labelID int) (*Label, error) {
+func (s *databaseService) Label(ctx context.Context, labelID int) (*Label, error) {
 	var label *Label
-	err := request(s.url+labelsURI+strconv.Itoa(labelID), nil, &label)
+	err := s.request(ctx, s.url+labelsURI+strconv.Itoa(labelID), nil, &label)
 	return label, err
 }
 
@@ -169,12 +283,22 @@ type LabelReleases struct {
 	Releases   []ReleaseSource `json:"releases"`
 }
 
-func (s *databaseService) LabelReleases(labelID int, pagination *Pagination) (*LabelReleases, error) {
+func (s *databaseService) LabelReleases(ctx context.Context, labelID int, pagination *Pagination) (*LabelReleases, error) {
+	if err := pagination.validate(validReleasesSort); err != nil {
+		return nil, err
+	}
 	var releases *LabelReleases
-	err := request(s.url+labelsURI+strconv.Itoa(labelID)+"/releases", pagination.params(), &releases)
+	err := s.request(ctx, s.url+labelsURI+strconv.Itoa(labelID)+"/releases", pagination.params(), &releases)
 	return releases, err
 }
 
+func (s *databaseService) LabelReleasesPager(labelID int, pagination *Pagination) *Pager[ReleaseSource] {
+	if err := pagination.validate(validReleasesSort); err != nil {
+		return errPager[ReleaseSource](err)
+	}
+	return newPager[ReleaseSource](s.client, s.url+labelsURI+strconv.Itoa(labelID)+"/releases", pagination.params(), "releases")
+}
+
 // Master resource represents a set of similar releases.
 // Masters (also known as `master releases`) have a `main release` which is often the chronologically earliest.
 // More information https://www.discogs.com/developers#page:database,header:database-master-release
@@ -201,9 +325,9 @@ type Master struct {
 	DataQuality          string         `json:"data_quality"`
 }
 
-func (s *databaseService) Master(masterID int) (*Master, error) {
+func (s *databaseService) Master(ctx context.Context, masterID int) (*Master, error) {
 	var master *Master
-	err := request(s.url+mastersURI+strconv.Itoa(masterID), nil, &master)
+	err := s.request(ctx, s.url+mastersURI+strconv.Itoa(masterID), nil, &master)
 	return master, err
 }
 
@@ -213,8 +337,80 @@ type MasterVersions struct {
 	Versions   []Version `json:"versions"`
 }
 
-func (s *databaseService) MasterVersions(masterID int, pagination *Pagination) (*MasterVersions, error) {
+var validMasterVersionsSort = newSortKeys(
+	SortByReleased,
+	SortByTitle,
+	SortByFormat,
+	SortByLabel,
+	SortByCatno,
+	SortByCountry,
+)
+
+// MasterVersionsRequest describes filters of master versions.
+type MasterVersionsRequest struct {
+	Format    string    // only versions of the format, e.g. Vinyl (optional)
+	Label     string    // only versions released by the label (optional)
+	Released  string    // only versions released in the year (optional)
+	Country   string    // only versions released in the country (optional)
+	Sort      SortKey   // released, title, format, label, catno or country (optional)
+	SortOrder SortOrder // asc, desc (optional)
+
+	Page    int
+	PerPage int
+}
+
+func (r *MasterVersionsRequest) params() url.Values {
+	if r == nil {
+		return nil
+	}
+
+	params := url.Values{}
+	if r.Format != "" {
+		params.Set("format", r.Format)
+	}
+	if r.Label != "" {
+		params.Set("label", r.Label)
+	}
+	if r.Released != "" {
+		params.Set("released", r.Released)
+	}
+	if r.Country != "" {
+		params.Set("country", r.Country)
+	}
+	if r.Sort != "" {
+		params.Set("sort", string(r.Sort))
+	}
+	if r.SortOrder != "" {
+		params.Set("sort_order", string(r.SortOrder))
+	}
+	if r.Page != 0 {
+		params.Set("page", strconv.Itoa(r.Page))
+	}
+	if r.PerPage != 0 {
+		params.Set("per_page", strconv.Itoa(r.PerPage))
+	}
+	return params
+}
+
+func (r *MasterVersionsRequest) validate() error {
+	if r == nil {
+		return nil
+	}
+	return validMasterVersionsSort.validate(r.Sort, r.SortOrder)
+}
+
+func (s *databaseService) MasterVersions(ctx context.Context, masterID int, req *MasterVersionsRequest) (*MasterVersions, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
 	var versions *MasterVersions
-	err := request(s.url+mastersURI+strconv.Itoa(masterID)+"/versions", pagination.params(), &versions)
+	err := s.request(ctx, s.url+mastersURI+strconv.Itoa(masterID)+"/versions", req.params(), &versions)
 	return versions, err
 }
+
+func (s *databaseService) MasterVersionsPager(masterID int, req *MasterVersionsRequest) *Pager[Version] {
+	if err := req.validate(); err != nil {
+		return errPager[Version](err)
+	}
+	return newPager[Version](s.client, s.url+mastersURI+strconv.Itoa(masterID)+"/versions", req.params(), "versions")
+}
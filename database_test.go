@@ -1,6 +1,7 @@
 package discogs
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"log"
@@ -24,6 +25,12 @@ func DatabaseServer(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
+	case "/releases/8138518/stats":
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, databaseReleaseStatsJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
 	case "/masters/718441":
 		w.WriteHeader(http.StatusOK)
 		if _, err := io.WriteString(w, masterJson); err != nil {
@@ -41,6 +48,30 @@ func DatabaseServer(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func ReleaseRatingServer(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/releases/249504/rating/"+testUsername {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	switch r.Method {
+	case "GET":
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, userReleaseRatingJson)
+	case "PUT":
+		var body map[string]int
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["rating"] != 5 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, userReleaseRatingJson)
+	case "DELETE":
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
 func compareJson(t *testing.T, got, want string) {
 	var g, w interface{}
 	if err := json.Unmarshal([]byte(got), &g); err != nil {
@@ -60,7 +91,7 @@ func TestDatabaseServiceRelease(t *testing.T) {
 	defer ts.Close()
 
 	d := initDiscogsClient(t, &Options{URL: ts.URL})
-	release, err := d.Release(8138518)
+	release, err := d.Release(context.Background(), 8138518)
 	if err != nil {
 		t.Fatalf("failed to get release: %s", err)
 	}
@@ -73,12 +104,45 @@ func TestDatabaseServiceRelease(t *testing.T) {
 	compareJson(t, string(json), releaseJson)
 }
 
+func TestDatabaseServiceReleaseStats(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(DatabaseServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+	stats, err := d.ReleaseStats(context.Background(), 8138518)
+	if err != nil {
+		t.Fatalf("failed to get release stats: %s", err)
+	}
+
+	json, err := json.Marshal(stats)
+	if err != nil {
+		t.Fatalf("failed to marshal release stats: %s", err)
+	}
+
+	compareJson(t, string(json), databaseReleaseStatsJson)
+}
+
+func TestDatabaseServiceReleaseIDErrors(t *testing.T) {
+	d := initDiscogsClient(t, nil)
+	ctx := context.Background()
+
+	if _, err := d.Release(ctx, 0); err != ErrInvalidReleaseID {
+		t.Errorf("release err got=%v; want=%s", err, ErrInvalidReleaseID)
+	}
+	if _, err := d.ReleaseRating(ctx, 0); err != ErrInvalidReleaseID {
+		t.Errorf("release rating err got=%v; want=%s", err, ErrInvalidReleaseID)
+	}
+	if _, err := d.ReleaseStats(ctx, 0); err != ErrInvalidReleaseID {
+		t.Errorf("release stats err got=%v; want=%s", err, ErrInvalidReleaseID)
+	}
+}
+
 func TestDatabaseServiceMaster(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(DatabaseServer))
 	defer ts.Close()
 
 	d := initDiscogsClient(t, &Options{URL: ts.URL})
-	master, err := d.Master(718441)
+	master, err := d.Master(context.Background(), 718441)
 	if err != nil {
 		t.Fatalf("failed to get master: %s", err)
 	}
@@ -95,7 +159,7 @@ func TestDatabaseServiceArtist(t *testing.T) {
 	defer ts.Close()
 
 	d := initDiscogsClient(t, &Options{URL: ts.URL})
-	artist, err := d.Artist(38661)
+	artist, err := d.Artist(context.Background(), 38661)
 	if err != nil {
 		t.Fatalf("failed to get master: %s", err)
 	}
@@ -106,3 +170,90 @@ func TestDatabaseServiceArtist(t *testing.T) {
 	}
 	compareJson(t, string(json), artistJson)
 }
+
+func TestDatabaseServiceReleaseRatingByUser(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(ReleaseRatingServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+	ctx := context.Background()
+
+	rating, err := d.ReleaseRatingByUser(ctx, 249504, testUsername)
+	if err != nil {
+		t.Fatalf("failed to get release rating: %s", err)
+	}
+	json, err := json.Marshal(rating)
+	if err != nil {
+		t.Fatalf("failed to marshal release rating: %s", err)
+	}
+	compareJson(t, string(json), userReleaseRatingJson)
+
+	if _, err := d.UpdateReleaseRating(ctx, 249504, testUsername, 5); err != nil {
+		t.Fatalf("failed to update release rating: %s", err)
+	}
+	if _, err := d.UpdateReleaseRating(ctx, 249504, testUsername, 0); err != ErrInvalidRating {
+		t.Errorf("err got=%v; want=%s", err, ErrInvalidRating)
+	}
+	if err := d.DeleteReleaseRating(ctx, 249504, testUsername); err != nil {
+		t.Fatalf("failed to delete release rating: %s", err)
+	}
+	if err := d.DeleteReleaseRating(ctx, 249504, ""); err != ErrInvalidUsername {
+		t.Errorf("err got=%v; want=%s", err, ErrInvalidUsername)
+	}
+}
+
+func TestDatabaseServiceMasterVersions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := "country=UK&format=Vinyl&label=Decca&page=2&per_page=10&released=1969&sort=released&sort_order=desc"
+		if r.URL.Path != "/masters/1000/versions" || r.URL.RawQuery != want {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, masterVersionsJson)
+	}))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+	versions, err := d.MasterVersions(context.Background(), 1000, &MasterVersionsRequest{
+		Format:    "Vinyl",
+		Label:     "Decca",
+		Released:  "1969",
+		Country:   "UK",
+		Sort:      SortByReleased,
+		SortOrder: "desc",
+		Page:      2,
+		PerPage:   10,
+	})
+	if err != nil {
+		t.Fatalf("failed to get master versions: %s", err)
+	}
+
+	json, err := json.Marshal(versions)
+	if err != nil {
+		t.Fatalf("failed to marshal master versions: %s", err)
+	}
+
+	compareJson(t, string(json), masterVersionsJson)
+}
+
+func TestDatabaseServiceReleasesSortErrors(t *testing.T) {
+	d := initDiscogsClient(t, nil)
+	ctx := context.Background()
+
+	if _, err := d.ArtistReleases(ctx, 38661, &Pagination{Sort: SortByRating}); err != ErrInvalidSortKey {
+		t.Errorf("artist releases err got=%v; want=%s", err, ErrInvalidSortKey)
+	}
+	if _, err := d.LabelReleases(ctx, 1, &Pagination{Sort: SortByYear, SortOrder: "up"}); err != ErrInvalidSortOrder {
+		t.Errorf("label releases err got=%v; want=%s", err, ErrInvalidSortOrder)
+	}
+	if _, err := d.ArtistReleasesPager(38661, &Pagination{Sort: SortByAdded}).Next(ctx); err != ErrInvalidSortKey {
+		t.Errorf("artist releases pager err got=%v; want=%s", err, ErrInvalidSortKey)
+	}
+	if _, err := d.MasterVersions(ctx, 1000, &MasterVersionsRequest{Sort: SortByYear}); err != ErrInvalidSortKey {
+		t.Errorf("master versions err got=%v; want=%s", err, ErrInvalidSortKey)
+	}
+	if _, err := d.MasterVersionsPager(1000, &MasterVersionsRequest{Sort: SortByTitle, SortOrder: "up"}).Next(ctx); err != ErrInvalidSortOrder {
+		t.Errorf("master versions pager err got=%v; want=%s", err, ErrInvalidSortOrder)
+	}
+}
@@ -2,12 +2,15 @@ package discogs
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
 	"encoding/json"
-	"fmt"
 	"io"
-	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 )
 
 const (
@@ -19,19 +22,62 @@ type Options struct {
 	// Discogs API endpoint (optional).
 	URL string
 	// Currency to use (optional, default is USD).
-	Currency string
+	Currency Currency
+	// Currencies accepted in addition to the listed ones (optional).
+	// Use it for currencies added by discogs which aren't listed yet.
+	Currencies []Currency
 	// UserAgent to to call discogs api with.
 	UserAgent string
 	// Token provided by discogs (optional).
 	Token string
+	// OAuth credentials to sign requests with (optional).
+	// If set, Token is ignored.
+	OAuth *OAuth
+	// Throttle requests according to X-Discogs-Ratelimit headers (optional).
+	// If set, requests wait for the rate limit to free up instead of returning ErrTooManyRequests,
+	// for as long as their context allows.
+	Throttle bool
+	// Retry policy for requests failed with 429 or 5xx status (optional, no retries by default).
+	Retry *RetryPolicy
+	// HTTPClient to send requests with (optional, default is a new http.Client).
+	// Use it to set timeouts, proxies or a custom http.RoundTripper.
+	HTTPClient *http.Client
+	// Cache to revalidate GET responses with ETags (optional, no caching by default).
+	// Use NewMemoryCache for an in-memory cache.
+	Cache Cache
+	// Hooks called around every request sent to discogs (optional).
+	// Use them for logging, metrics or tracing.
+	Hooks *Hooks
+	// MediaType of responses, which sets the format of text fields (optional, MediaTypeDiscogs by default).
+	MediaType MediaType
+}
+
+// Hooks are called for every attempt of every request, including retries.
+// They must not read or close request and response bodies.
+type Hooks struct {
+	// OnRequest is called right before a signed request is sent (optional).
+	OnRequest func(r *http.Request)
+	// OnResponse is called once a response is received, with the time it took (optional).
+	OnResponse func(response *http.Response, elapsed time.Duration)
 }
 
 // Discogs is an interface for making Discogs API requests.
 type Discogs interface {
+	BatchService
 	CollectionService
 	DatabaseService
+	ImagesService
+	InventoryService
+	ListsService
 	MarketPlaceService
+	OAuthService
+	OrdersService
 	SearchService
+	UserService
+	WantlistService
+
+	// RateLimit returns the rate limit state reported by the last response.
+	RateLimit() RateLimit
 }
 
 type discogs struct {
@@ -39,27 +85,52 @@ type discogs struct {
 	DatabaseService
 	SearchService
 	MarketPlaceService
+	OAuthService
+	InventoryService
+	ListsService
+	OrdersService
+	UserService
+	WantlistService
+	ImagesService
+	BatchService
+
+	c *client
 }
 
-var header *http.Header
+func (d discogs) RateLimit() RateLimit {
+	return d.c.limiter.state()
+}
+
+// client sends requests to discogs API and is shared by all services.
+// Each client owns its headers, so several clients can be used concurrently.
+type client struct {
+	httpClient *http.Client
+	header     http.Header
+	oauth      *OAuth
+	limiter    *rateLimiter
+	throttle   bool
+	retry      *RetryPolicy
+	cache      Cache
+	hooks      *Hooks
+	mediaType  MediaType
+	currencies []Currency
+}
 
 // New returns a new discogs API client.
 func New(o *Options) (Discogs, error) {
-	header = &http.Header{}
-
 	if o == nil || o.UserAgent == "" {
 		return nil, ErrUserAgentInvalid
 	}
 
+	header := http.Header{}
 	header.Add("User-Agent", o.UserAgent)
 
-	cur, err := currency(o.Currency)
-	if err != nil {
-		return nil, err
+	if o.MediaType != "" && !o.MediaType.valid() {
+		return nil, ErrInvalidMediaType
 	}
 
 	// set token, it's required for some queries like search
-	if o.Token != "" {
+	if o.OAuth == nil && o.Token != "" {
 		header.Add("Authorization", "Discogs token="+o.Token)
 	}
 
@@ -67,76 +138,327 @@ func New(o *Options) (Discogs, error) {
 		o.URL = discogsAPI
 	}
 
+	c := &client{
+		httpClient: o.HTTPClient,
+		header:     header,
+		oauth:      o.OAuth,
+		limiter:    &rateLimiter{},
+		throttle:   o.Throttle,
+		retry:      o.Retry,
+		cache:      o.Cache,
+		hooks:      o.Hooks,
+		mediaType:  o.MediaType,
+	}
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{}
+	}
+	for _, extra := range o.Currencies {
+		if !extra.validCode() {
+			return nil, ErrCurrencyNotSupported
+		}
+		c.currencies = append(c.currencies, extra)
+	}
+
+	cur, err := c.validCurrency(o.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	database := newDatabaseService(c, o.URL, cur)
 	return discogs{
-		newCollectionService(o.URL + "/users"),
-		newDatabaseService(o.URL, cur),
-		newSearchService(o.URL + "/database/search"),
-		newMarketPlaceService(o.URL+"/marketplace", cur),
+		newCollectionService(c, o.URL+"/users"),
+		database,
+		newSearchService(c, o.URL+"/database/search"),
+		newMarketPlaceService(c, o.URL+"/marketplace", o.URL+"/users", cur),
+		newOAuthService(c, o.URL+"/oauth"),
+		newInventoryService(c, o.URL+"/inventory"),
+		newListsService(c, o.URL),
+		newOrdersService(c, o.URL+"/marketplace/orders"),
+		newUserService(c, o.URL),
+		newWantlistService(c, o.URL+"/users"),
+		newImagesService(c, o.URL),
+		newBatchService(database),
+		c,
 	}, nil
 }
 
-// currency validates currency for marketplace data.
-// Defaults to the authenticated users currency. Must be one of the following:
-// USD GBP EUR CAD AUD JPY CHF MXN BRL NZD SEK ZAR
-func currency(c string) (string, error) {
-	switch c {
-	case "USD", "GBP", "EUR", "CAD", "AUD", "JPY", "CHF", "MXN", "BRL", "NZD", "SEK", "ZAR":
-		return c, nil
-	case "":
-		return "USD", nil
-	default:
-		return "", ErrCurrencyNotSupported
+// CallOption overrides a client option for a single call.
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	currency Currency
+}
+
+// WithCurrency sets currency of prices returned by a single call,
+// instead of the one set by Options.Currency.
+func WithCurrency(c Currency) CallOption {
+	return func(o *callOptions) {
+		o.currency = c
 	}
 }
 
-func request(path string, params url.Values, resp interface{}) error {
-	return requestWithMethod("GET", path, params, resp)
+// callCurrency returns currency set by opts or def if none is set.
+func (c *client) callCurrency(def Currency, opts []CallOption) (Currency, error) {
+	var o callOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.currency == "" {
+		return def, nil
+	}
+	return c.validCurrency(o.currency)
 }
 
-func requestWithMethod(method string, path string, params url.Values, resp interface{}) error {
-	return verboseRequest(method, path, params, nil, resp)
+func (c *client) request(ctx context.Context, path string, params url.Values, resp interface{}) error {
+	return c.requestWithMethod(ctx, "GET", path, params, resp)
 }
 
-func requestWithJSONBody(method string, path string, params url.Values, body interface{}, resp interface{}) error {
+func (c *client) requestWithMethod(ctx context.Context, method string, path string, params url.Values, resp interface{}) error {
+	return c.verboseRequest(ctx, method, path, params, nil, resp)
+}
+
+func (c *client) requestWithJSONBody(ctx context.Context, method string, path string, params url.Values, body interface{}, resp interface{}) error {
 	bodyBytes, err := json.Marshal(body)
 	if err != nil {
 		return err
 	}
-	return verboseRequest(method, path, params, bytes.NewBuffer(bodyBytes), resp)
+	return c.verboseRequest(ctx, method, path, params, bytes.NewBuffer(bodyBytes), resp)
 }
 
-func verboseRequest(method string, path string, params url.Values, requestBody io.Reader, resp interface{}) error {
-	r, err := http.NewRequest(method, path+"?"+params.Encode(), requestBody)
+func (c *client) verboseRequest(ctx context.Context, method string, path string, params url.Values, requestBody io.Reader, resp interface{}) error {
+	r, err := c.newRequest(ctx, method, path, params, requestBody)
 	if err != nil {
 		return err
 	}
-	r.Header = *header
 	r.Header.Add("Content-Type", "application/json")
+	m, err := mediaType(ctx, c.mediaType)
+	if err != nil {
+		return err
+	}
+	if m != "" {
+		r.Header.Set("Accept", string(m))
+	}
+
+	var (
+		key    string
+		cached CachedResponse
+	)
+	if c.cache != nil && method == "GET" {
+		key = c.cacheKey(r)
+		var ok bool
+		if cached, ok = c.cache.Get(key); ok {
+			r.Header.Set("If-None-Match", cached.ETag)
+		}
+	}
 
-	client := &http.Client{}
-	response, err := client.Do(r)
+	response, err := c.do(r)
 	if err != nil {
 		return err
 	}
 	defer response.Body.Close()
 
-	if response.StatusCode != http.StatusOK {
+	switch response.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusNotModified:
+		setRawResponse(ctx, cached.Body)
+		return json.Unmarshal(cached.Body, &resp)
+	}
+
+	// only bodies stored in the cache or asked for raw are buffered,
+	// others are decoded as they are read
+	etag := response.Header.Get("ETag")
+	if store := key != "" && etag != ""; store || wantsRawResponse(ctx) {
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+		if store {
+			c.cache.Set(key, CachedResponse{ETag: etag, Body: body})
+		}
+		setRawResponse(ctx, body)
+		return json.Unmarshal(body, &resp)
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&resp); err != nil {
+		return err
+	}
+	// drain what's left after the JSON value so the connection can be reused
+	_, err = io.Copy(io.Discard, response.Body)
+	return err
+}
+
+// newRequest creates a request carrying the client's headers.
+func (c *client) newRequest(ctx context.Context, method string, path string, params url.Values, body io.Reader) (*http.Request, error) {
+	if p, ok := ctx.Value(oauthParamsKey{}).(*oauthParams); ok && p == nil {
+		return nil, ErrInvalidOAuthToken
+	}
+	if token, ok := contextToken(ctx); ok && token == "" {
+		return nil, ErrInvalidToken
+	}
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+	r, err := http.NewRequestWithContext(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	r.Header = c.header.Clone()
+	if token, ok := contextToken(ctx); ok {
+		r.Header.Set("Authorization", "Discogs token="+token)
+	}
+	if !hasCredentials(ctx) {
+		r.Header.Del("Authorization")
+	}
+	return r, nil
+}
+
+// newMultipartRequest creates a request with file sent as a multipart form field.
+func (c *client) newMultipartRequest(ctx context.Context, method string, path string, field string, filename string, file io.Reader) (*http.Request, error) {
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	part, err := w.CreateFormFile(field, filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	r, err := c.newRequest(ctx, method, path, nil, body)
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Content-Type", w.FormDataContentType())
+	return r, nil
+}
+
+// do signs and sends the request and checks the response status.
+// The caller must close the response body if err is nil.
+func (c *client) do(r *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		response, err := c.send(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if delay, ok := c.retryDelay(r, response, attempt); ok {
+			response.Body.Close()
+			if response.StatusCode == http.StatusTooManyRequests && c.throttle {
+				// the limiter delays the next attempt in send
+				c.limiter.block(delay)
+			} else if err := sleep(r.Context(), delay); err != nil {
+				return nil, err
+			}
+			if r, err = cloneRequest(r); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		switch response.StatusCode {
-		case http.StatusNoContent:
-			return nil
-		case http.StatusUnauthorized:
-			return ErrUnauthorized
-		case http.StatusTooManyRequests:
-			return ErrTooManyRequests
-		default:
-			return fmt.Errorf("unknown error: %s", response.Status)
+		case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+			return response, nil
+		case http.StatusNotModified:
+			// only conditional requests of cached responses are answered with 304
+			if r.Header.Get("If-None-Match") != "" {
+				return response, nil
+			}
 		}
+		err = newAPIError(response)
+		response.Body.Close()
+		return nil, err
 	}
+}
 
-	body, err := ioutil.ReadAll(response.Body)
+// send waits for the rate limiter if throttling is enabled,
+// signs and sends the request once.
+func (c *client) send(r *http.Request) (*http.Response, error) {
+	if c.throttle {
+		if err := c.limiter.wait(r.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := contextToken(r.Context()); !ok && hasCredentials(r.Context()) {
+		if c.oauth != nil {
+			if err := c.oauth.sign(r); err != nil {
+				return nil, err
+			}
+		} else if r.Context().Value(oauthParamsKey{}) != nil {
+			return nil, ErrOAuthRequired
+		}
+	}
+
+	// the default transport decompresses gzip only if it asked for it itself,
+	// so ask explicitly to get compressed responses with any http.RoundTripper.
+	if r.Header.Get("Accept-Encoding") == "" {
+		r.Header.Set("Accept-Encoding", "gzip")
+	}
+
+	if c.hooks != nil && c.hooks.OnRequest != nil {
+		c.hooks.OnRequest(r)
+	}
+	start := time.Now()
+	response, err := c.httpClient.Do(r)
+	if err != nil {
+		return nil, err
+	}
+	if c.hooks != nil && c.hooks.OnResponse != nil {
+		c.hooks.OnResponse(response, time.Since(start))
+	}
+	c.limiter.update(response.Header)
+	if err := decompress(response); err != nil {
+		response.Body.Close()
+		return nil, err
+	}
+	return response, nil
+}
+
+// decompress replaces a gzip encoded response body with a decoded one.
+func decompress(response *http.Response) error {
+	if !strings.EqualFold(response.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+	// bodies of 204 and 304 responses are empty
+	if response.StatusCode == http.StatusNoContent || response.StatusCode == http.StatusNotModified {
+		return nil
+	}
+	zr, err := gzip.NewReader(response.Body)
 	if err != nil {
 		return err
 	}
+	response.Body = &gzipBody{Reader: zr, body: response.Body}
+	response.Header.Del("Content-Encoding")
+	response.Header.Del("Content-Length")
+	response.ContentLength = -1
+	response.Uncompressed = true
+	return nil
+}
 
-	return json.Unmarshal(body, &resp)
+// gzipBody decodes a gzip encoded response body.
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (b *gzipBody) Close() error {
+	b.Reader.Close()
+	return b.body.Close()
+}
+
+// cloneRequest returns a copy of the already sent request to send it again.
+func cloneRequest(r *http.Request) (*http.Request, error) {
+	clone := r.Clone(r.Context())
+	if r.GetBody != nil {
+		body, err := r.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+	return clone, nil
 }
@@ -1,7 +1,15 @@
 package discogs
 
 import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 const (
@@ -63,8 +71,8 @@ func TestNew(t *testing.T) {
 
 func TestCurrency(t *testing.T) {
 	tests := []struct {
-		currency string
-		want     string
+		currency Currency
+		want     Currency
 		err      error
 	}{
 		{currency: "", want: "USD"},
@@ -80,10 +88,11 @@ func TestCurrency(t *testing.T) {
 		{currency: "NZD", want: "NZD"},
 		{currency: "SEK", want: "SEK"},
 		{currency: "ZAR", want: "ZAR"},
+		{currency: "DKK", want: "DKK"},
 		{currency: "RUR", want: "", err: ErrCurrencyNotSupported},
 	}
 	for i, tt := range tests {
-		cur, err := currency(tt.currency)
+		cur, err := (&client{}).validCurrency(tt.currency)
 		if err != tt.err {
 			t.Errorf("#%d err got=%s; want=%s", i, err, tt.err)
 		}
@@ -92,3 +101,158 @@ func TestCurrency(t *testing.T) {
 		}
 	}
 }
+
+func TestOptionsCurrencies(t *testing.T) {
+	d, err := New(&Options{UserAgent: testUserAgent, Currency: "NOK", Currencies: []Currency{"NOK"}})
+	if err != nil {
+		t.Fatalf("failed to create client with extra currency: %s", err)
+	}
+	if cur, err := d.(discogs).c.validCurrency("NOK"); err != nil || cur != "NOK" {
+		t.Errorf("currency got=%s, %v; want=NOK", cur, err)
+	}
+	// extra currencies are per client
+	if _, err := (&client{}).validCurrency("NOK"); err != ErrCurrencyNotSupported {
+		t.Errorf("err got=%v; want=%s", err, ErrCurrencyNotSupported)
+	}
+	if _, err := New(&Options{UserAgent: testUserAgent, Currencies: []Currency{"kroner"}}); err != ErrCurrencyNotSupported {
+		t.Errorf("err got=%v; want=%s", err, ErrCurrencyNotSupported)
+	}
+}
+
+func TestRequestContextCanceled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(DatabaseServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := d.Release(ctx, 8138518); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err got=%v; want=%s", err, context.Canceled)
+	}
+}
+
+type countingTransport struct {
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestCustomHTTPClient(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(DatabaseServer))
+	defer ts.Close()
+
+	transport := &countingTransport{}
+	d := initDiscogsClient(t, &Options{URL: ts.URL, HTTPClient: &http.Client{Transport: transport}})
+
+	if _, err := d.Release(context.Background(), 8138518); err != nil {
+		t.Fatalf("failed to get release: %s", err)
+	}
+	if _, err := d.Master(context.Background(), 718441); err != nil {
+		t.Fatalf("failed to get master: %s", err)
+	}
+
+	if transport.requests != 2 {
+		t.Errorf("requests got=%d; want=%d", transport.requests, 2)
+	}
+}
+
+func TestClientsAreIsolated(t *testing.T) {
+	agents := make(chan string, 2)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		agents <- r.Header.Get("User-Agent")
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, folderJson)
+	}))
+	defer ts.Close()
+
+	first := initDiscogsClient(t, &Options{URL: ts.URL, UserAgent: "FirstClient/1.0"})
+	second := initDiscogsClient(t, &Options{URL: ts.URL, UserAgent: "SecondClient/1.0"})
+
+	if _, err := first.Folder(context.Background(), testUsername, 0); err != nil {
+		t.Fatalf("failed to get folder: %s", err)
+	}
+	if got := <-agents; got != "FirstClient/1.0" {
+		t.Errorf("user-agent got=%s; want=%s", got, "FirstClient/1.0")
+	}
+
+	if _, err := second.Folder(context.Background(), testUsername, 0); err != nil {
+		t.Fatalf("failed to get folder: %s", err)
+	}
+	if got := <-agents; got != "SecondClient/1.0" {
+		t.Errorf("user-agent got=%s; want=%s", got, "SecondClient/1.0")
+	}
+}
+
+func TestGzipResponse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			w.WriteHeader(http.StatusNotAcceptable)
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusOK)
+		zw := gzip.NewWriter(w)
+		_, _ = io.WriteString(zw, folderJson)
+		_ = zw.Close()
+	}))
+	defer ts.Close()
+
+	// a transport without automatic decompression
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DisableCompression = true
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL, HTTPClient: &http.Client{Transport: transport}})
+	folder, err := d.Folder(context.Background(), testUsername, 0)
+	if err != nil {
+		t.Fatalf("failed to get folder: %s", err)
+	}
+	if folder.Name != "All" {
+		t.Errorf("folder name got=%s; want=All", folder.Name)
+	}
+}
+
+func TestHooks(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, folderJson)
+	}))
+	defer ts.Close()
+
+	var paths []string
+	var statuses []int
+	hooks := &Hooks{
+		OnRequest: func(r *http.Request) {
+			paths = append(paths, r.URL.Path)
+		},
+		OnResponse: func(response *http.Response, elapsed time.Duration) {
+			if elapsed <= 0 {
+				t.Errorf("elapsed got=%s; want positive", elapsed)
+			}
+			statuses = append(statuses, response.StatusCode)
+		},
+	}
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL, Throttle: true, Hooks: hooks})
+	if _, err := d.Folder(context.Background(), testUsername, 0); err != nil {
+		t.Fatalf("failed to get folder: %s", err)
+	}
+
+	want := "/users/" + testUsername + "/collection/folders/0"
+	if len(paths) != 2 || paths[0] != want || paths[1] != want {
+		t.Errorf("requests got=%v; want two of %s", paths, want)
+	}
+	if len(statuses) != 2 || statuses[0] != http.StatusTooManyRequests || statuses[1] != http.StatusOK {
+		t.Errorf("responses got=%v; want=[429 200]", statuses)
+	}
+}
@@ -0,0 +1,276 @@
+// Package discogstest provides a fake discogs API server for tests.
+//
+// Seed the server with fixtures and point a client at it:
+//
+//	srv := discogstest.NewServer()
+//	defer srv.Close()
+//	srv.AddRelease(&discogs.Release{ID: 1, Title: "Stockholm"})
+//
+//	client, err := srv.Client()
+//	release, err := client.Release(ctx, 1)
+package discogstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hrfee/go-discogs"
+)
+
+const defaultPerPage = 50
+
+// Server is a fake discogs API serving seeded resources.
+// Releases, masters, artists, labels and collections are served from fixtures
+// added with Add... methods, any other request may be answered with Handle.
+// Unknown resources are answered with 404 Not Found.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	releases    map[int]*discogs.Release
+	masters     map[int]*discogs.Master
+	artists     map[int]*discogs.Artist
+	labels      map[int]*discogs.Label
+	collections map[string][]discogs.CollectionItemSource
+	canned      map[string]cannedResponse
+}
+
+type cannedResponse struct {
+	status int
+	body   string
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		releases:    map[int]*discogs.Release{},
+		masters:     map[int]*discogs.Master{},
+		artists:     map[int]*discogs.Artist{},
+		labels:      map[int]*discogs.Label{},
+		collections: map[string][]discogs.CollectionItemSource{},
+		canned:      map[string]cannedResponse{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Client returns a discogs client sending requests to the server.
+func (s *Server) Client() (discogs.Discogs, error) {
+	return discogs.New(&discogs.Options{
+		URL:       s.URL,
+		UserAgent: "discogstest",
+	})
+}
+
+// AddRelease adds a release served at /releases/{id}.
+func (s *Server) AddRelease(release *discogs.Release) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.releases[release.ID] = release
+}
+
+// AddMaster adds a master release served at /masters/{id}.
+func (s *Server) AddMaster(master *discogs.Master) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.masters[master.ID] = master
+}
+
+// AddArtist adds an artist served at /artists/{id}.
+func (s *Server) AddArtist(artist *discogs.Artist) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.artists[artist.ID] = artist
+}
+
+// AddLabel adds a label served at /labels/{id}.
+func (s *Server) AddLabel(label *discogs.Label) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.labels[label.ID] = label
+}
+
+// AddCollectionItem adds an item to a folder of the user's collection.
+// Folders are served at /users/{username}/collection/folders,
+// items are also listed in folder 0 (All).
+func (s *Server) AddCollectionItem(username string, folderID int, item discogs.CollectionItemSource) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	item.FolderID = folderID
+	s.collections[username] = append(s.collections[username], item)
+}
+
+// Handle answers requests with method and path with a canned JSON body.
+// It takes precedence over seeded resources.
+func (s *Server) Handle(method, path string, status int, body string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.canned[method+" "+path] = cannedResponse{status: status, body: body}
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if c, ok := s.canned[r.Method+" "+r.URL.Path]; ok {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(c.status)
+		fmt.Fprint(w, c.body)
+		return
+	}
+	if r.Method != "GET" {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed.")
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case len(parts) == 2 && parts[0] == "releases":
+		serveByID(w, parts[1], s.releases, "Release not found.")
+	case len(parts) == 2 && parts[0] == "masters":
+		serveByID(w, parts[1], s.masters, "Master not found.")
+	case len(parts) == 2 && parts[0] == "artists":
+		serveByID(w, parts[1], s.artists, "Artist not found.")
+	case len(parts) == 2 && parts[0] == "labels":
+		serveByID(w, parts[1], s.labels, "Label not found.")
+	case len(parts) >= 4 && parts[0] == "users" && parts[2] == "collection" && parts[3] == "folders":
+		s.serveCollection(w, r, parts[1], parts[4:])
+	default:
+		writeError(w, http.StatusNotFound, "The requested resource was not found.")
+	}
+}
+
+func serveByID[T any](w http.ResponseWriter, id string, resources map[int]*T, notFound string) {
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		writeError(w, http.StatusNotFound, notFound)
+		return
+	}
+	resource, ok := resources[n]
+	if !ok {
+		writeError(w, http.StatusNotFound, notFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, resource)
+}
+
+// serveCollection serves folders of a collection and items in them.
+func (s *Server) serveCollection(w http.ResponseWriter, r *http.Request, username string, parts []string) {
+	items := s.collections[username]
+
+	if len(parts) == 0 {
+		writeJSON(w, http.StatusOK, discogs.CollectionFolders{Folders: s.folders(username, items)})
+		return
+	}
+
+	folderID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		writeError(w, http.StatusNotFound, "Folder not found.")
+		return
+	}
+	var folder *discogs.Folder
+	for _, f := range s.folders(username, items) {
+		if f.ID == folderID {
+			f := f
+			folder = &f
+		}
+	}
+	if folder == nil {
+		writeError(w, http.StatusNotFound, "Folder not found.")
+		return
+	}
+
+	switch {
+	case len(parts) == 1:
+		writeJSON(w, http.StatusOK, folder)
+	case len(parts) == 2 && parts[1] == "releases":
+		var inFolder []discogs.CollectionItemSource
+		for _, item := range items {
+			if folderID == 0 || item.FolderID == folderID {
+				inFolder = append(inFolder, item)
+			}
+		}
+		page, from, to := paginate(r, len(inFolder))
+		writeJSON(w, http.StatusOK, discogs.CollectionItems{Pagination: page, Items: inFolder[from:to]})
+	default:
+		writeError(w, http.StatusNotFound, "The requested resource was not found.")
+	}
+}
+
+// folders returns folder 0 (All) and folders with items of the user.
+func (s *Server) folders(username string, items []discogs.CollectionItemSource) []discogs.Folder {
+	folderURL := s.URL + "/users/" + username + "/collection/folders/"
+	folders := []discogs.Folder{{ID: 0, Name: "All", Count: len(items), ResourceURL: folderURL + "0"}}
+	index := map[int]int{}
+	for _, item := range items {
+		i, ok := index[item.FolderID]
+		if !ok {
+			name := "Folder " + strconv.Itoa(item.FolderID)
+			if item.FolderID == 1 {
+				name = "Uncategorized"
+			}
+			folders = append(folders, discogs.Folder{ID: item.FolderID, Name: name, ResourceURL: folderURL + strconv.Itoa(item.FolderID)})
+			i = len(folders) - 1
+			index[item.FolderID] = i
+		}
+		folders[i].Count++
+	}
+	return folders
+}
+
+// paginate returns pagination of n items and bounds of the requested page.
+func paginate(r *http.Request, n int) (discogs.Page, int, int) {
+	q := r.URL.Query()
+	page, _ := strconv.Atoi(q.Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	perPage, _ := strconv.Atoi(q.Get("per_page"))
+	if perPage < 1 {
+		perPage = defaultPerPage
+	}
+	pages := (n + perPage - 1) / perPage
+	if pages == 0 {
+		pages = 1
+	}
+
+	p := discogs.Page{Page: page, PerPage: perPage, Items: n, Pages: pages}
+	if page < pages {
+		next := *r.URL
+		next.Scheme, next.Host = "http", r.Host
+		q.Set("page", strconv.Itoa(page+1))
+		q.Set("per_page", strconv.Itoa(perPage))
+		next.RawQuery = q.Encode()
+		p.URLs.Next = next.String()
+	}
+
+	from := (page - 1) * perPage
+	if from > n {
+		from = n
+	}
+	to := from + perPage
+	if to > n {
+		to = n
+	}
+	return p, from, to
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"message": message})
+}
@@ -0,0 +1,88 @@
+package discogstest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hrfee/go-discogs"
+)
+
+func newClient(t *testing.T, srv *Server) discogs.Discogs {
+	t.Helper()
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+	return client
+}
+
+func TestServerRelease(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddRelease(&discogs.Release{ID: 1, Title: "Stockholm"})
+
+	client := newClient(t, srv)
+	ctx := context.Background()
+
+	release, err := client.Release(ctx, 1)
+	if err != nil {
+		t.Fatalf("failed to get release: %s", err)
+	}
+	if release.Title != "Stockholm" {
+		t.Errorf("title got=%s; want=Stockholm", release.Title)
+	}
+
+	_, err = client.Release(ctx, 2)
+	var apiErr *discogs.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound || apiErr.Message != "Release not found." {
+		t.Errorf("err got=%v; want 404 release not found", err)
+	}
+}
+
+func TestServerCollection(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	for id := 1; id <= 3; id++ {
+		srv.AddCollectionItem("test_user", 1, discogs.CollectionItemSource{ID: id, InstanceID: id})
+	}
+	srv.AddCollectionItem("test_user", 2, discogs.CollectionItemSource{ID: 4, InstanceID: 4})
+
+	client := newClient(t, srv)
+	ctx := context.Background()
+
+	folders, err := client.CollectionFolders(ctx, "test_user")
+	if err != nil {
+		t.Fatalf("failed to get folders: %s", err)
+	}
+	if len(folders.Folders) != 3 || folders.Folders[0].Count != 4 || folders.Folders[1].Count != 3 {
+		t.Errorf("folders got=%+v", folders.Folders)
+	}
+
+	var ids []int
+	err = client.CollectionItemsByFolderPager("test_user", 1, &discogs.Pagination{PerPage: 2}).ForEach(ctx, func(item discogs.CollectionItemSource) error {
+		ids = append(ids, item.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to get items: %s", err)
+	}
+	if len(ids) != 3 || ids[0] != 1 || ids[2] != 3 {
+		t.Errorf("items got=%v; want=[1 2 3]", ids)
+	}
+}
+
+func TestServerHandle(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.Handle("GET", "/releases/1/stats", http.StatusOK, `{"num_have": 2, "num_want": 1}`)
+
+	stats, err := newClient(t, srv).ReleaseStats(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("failed to get stats: %s", err)
+	}
+	if stats.NumHave != 2 || stats.NumWant != 1 {
+		t.Errorf("stats got=%+v", stats)
+	}
+}
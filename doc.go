@@ -12,10 +12,15 @@ a token parameter. This is token way example:
 
 	client, err := discogs.New(&discogs.Options{
 		UserAgent: "Some Name",
-		Currency:  "EUR", // optional, "USD" (default), "GBP", "EUR", "CAD", "AUD", "JPY", "CHF", "MXN", "BRL", "NZD", "SEK", "ZAR" are allowed
+		Currency:  discogs.CurrencyEUR, // optional, CurrencyUSD by default
 		Token:     "Some Token", // optional
 		URL:       "https://api.discogs.com", // optional
 	})
 
+Every method accepts a context.Context, which is used for the underlying HTTP
+request and can be used to set deadlines or cancel it:
+
+	release, err := client.Release(ctx, 9893847)
+
 */
 package discogs
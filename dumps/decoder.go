@@ -0,0 +1,94 @@
+// Package dumps decodes monthly discogs data dumps.
+//
+// Dumps are published at https://data.discogs.com as gzipped XML files of
+// artists, labels, masters and releases. Decoders stream them element by element
+// into the same structs the API client returns, so a dump is never loaded into memory whole.
+// Fields dumps don't carry, like resource URLs and marketplace data, are left empty.
+//
+//	f, err := os.Open("discogs_20240101_releases.xml.gz")
+//	gz, err := gzip.NewReader(f)
+//	d := dumps.NewReleaseDecoder(gz)
+//	err = d.ForEach(func(r *discogs.Release) error {
+//		fmt.Println(r.ID, r.Title)
+//		return nil
+//	})
+package dumps
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/hrfee/go-discogs"
+)
+
+// Decoder reads elements of a dump one at a time.
+type Decoder[T any] struct {
+	d       *xml.Decoder
+	element string
+	convert func(d *xml.Decoder, start xml.StartElement) (*T, error)
+}
+
+func newDecoder[T any, X any](r io.Reader, element string, convert func(*X) *T) *Decoder[T] {
+	return &Decoder[T]{
+		d:       xml.NewDecoder(r),
+		element: element,
+		convert: func(d *xml.Decoder, start xml.StartElement) (*T, error) {
+			var x X
+			if err := d.DecodeElement(&x, &start); err != nil {
+				return nil, err
+			}
+			return convert(&x), nil
+		},
+	}
+}
+
+// NewReleaseDecoder returns a decoder of a releases dump.
+func NewReleaseDecoder(r io.Reader) *Decoder[discogs.Release] {
+	return newDecoder(r, "release", (*xmlRelease).release)
+}
+
+// NewMasterDecoder returns a decoder of a masters dump.
+func NewMasterDecoder(r io.Reader) *Decoder[discogs.Master] {
+	return newDecoder(r, "master", (*xmlMaster).master)
+}
+
+// NewArtistDecoder returns a decoder of an artists dump.
+func NewArtistDecoder(r io.Reader) *Decoder[discogs.Artist] {
+	return newDecoder(r, "artist", (*xmlArtist).artist)
+}
+
+// NewLabelDecoder returns a decoder of a labels dump.
+func NewLabelDecoder(r io.Reader) *Decoder[discogs.Label] {
+	return newDecoder(r, "label", (*xmlLabel).label)
+}
+
+// Next decodes the next element of the dump.
+// It returns io.EOF once there are no more elements.
+func (d *Decoder[T]) Next() (*T, error) {
+	for {
+		tok, err := d.d.Token()
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := tok.(xml.StartElement); ok && start.Name.Local == d.element {
+			return d.convert(d.d, start)
+		}
+	}
+}
+
+// ForEach calls fn for every remaining element of the dump.
+// It stops at the first error returned by fn.
+func (d *Decoder[T]) ForEach(fn func(*T) error) error {
+	for {
+		v, err := d.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(v); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package dumps
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/hrfee/go-discogs"
+)
+
+const releasesXML = `<releases>
+<release id="1" status="Accepted">
+	<images><image height="600" type="primary" uri="" uri150="" width="600"/></images>
+	<artists><artist><id>1</id><name>The Persuader</name><anv></anv><join></join><role></role><tracks></tracks></artist></artists>
+	<title>Stockholm</title>
+	<labels><label catno="SK032" id="5" name="Svek"/></labels>
+	<formats><format name="Vinyl" qty="2" text=""><descriptions><description>12"</description><description>33 ⅓ RPM</description></descriptions></format></formats>
+	<genres><genre>Electronic</genre></genres>
+	<styles><style>Deep House</style></styles>
+	<country>Sweden</country>
+	<released>1999-03-00</released>
+	<data_quality>Needs Vote</data_quality>
+	<master_id is_main_release="true">5427</master_id>
+	<tracklist><track><position>A</position><title>Östermalm</title><duration>4:45</duration></track></tracklist>
+	<identifiers><identifier description="A-Side Runout" type="Matrix / Runout" value="MPO SK 032 A1"/></identifiers>
+	<videos><video duration="290" embed="true" src="https://www.youtube.com/watch?v=MIgQNVhYILA"><title>The Persuader - Östermalm</title><description>Östermalm</description></video></videos>
+	<companies><company><id>271046</id><name>The Globe Studios</name><catno></catno><entity_type>23</entity_type><entity_type_name>Recorded At</entity_type_name></company></companies>
+</release>
+<release id="2" status="Accepted"><title>Knockin' Boots Vol 2 Of 2</title><released>1998</released></release>
+</releases>`
+
+func TestReleaseDecoder(t *testing.T) {
+	d := NewReleaseDecoder(strings.NewReader(releasesXML))
+
+	r, err := d.Next()
+	if err != nil {
+		t.Fatalf("failed to decode release: %s", err)
+	}
+	if r.ID != 1 || r.Title != "Stockholm" || r.Year != 1999 || r.MasterID != 5427 || r.Country != "Sweden" {
+		t.Errorf("release got=%+v", r)
+	}
+	if len(r.Artists) != 1 || r.Artists[0].Name != "The Persuader" {
+		t.Errorf("artists got=%+v", r.Artists)
+	}
+	if len(r.Labels) != 1 || r.Labels[0].Catno != "SK032" {
+		t.Errorf("labels got=%+v", r.Labels)
+	}
+	if len(r.Formats) != 1 || len(r.Formats[0].Descriptions) != 2 || r.FormatQuantity != 2 {
+		t.Errorf("formats got=%+v, quantity=%d", r.Formats, r.FormatQuantity)
+	}
+	if len(r.Tracklist) != 1 || r.Tracklist[0].Title != "Östermalm" {
+		t.Errorf("tracklist got=%+v", r.Tracklist)
+	}
+	if len(r.Videos) != 1 || !r.Videos[0].Embed || r.Videos[0].Duration != 290 {
+		t.Errorf("videos got=%+v", r.Videos)
+	}
+	if len(r.Companies) != 1 || r.Companies[0].EntityTypeName != "Recorded At" {
+		t.Errorf("companies got=%+v", r.Companies)
+	}
+
+	if r, err = d.Next(); err != nil || r.ID != 2 || r.Year != 1998 {
+		t.Errorf("second release got=%+v, %v", r, err)
+	}
+	if _, err := d.Next(); err != io.EOF {
+		t.Errorf("err got=%v; want=%s", err, io.EOF)
+	}
+}
+
+func TestArtistDecoder(t *testing.T) {
+	const artistsXML = `<artists><artist>
+		<id>1</id><name>The Persuader</name><realname>Jesper Dahlbäck</realname>
+		<urls><url>https://en.wikipedia.org/wiki/Jesper_Dahlbäck</url></urls>
+		<namevariations><name>Persuader</name></namevariations>
+		<aliases><name id="239">Dahlbäck</name></aliases>
+		<groups><name id="1030">Pierre's Pfeiffer</name></groups>
+	</artist></artists>`
+
+	var artists []*discogs.Artist
+	err := NewArtistDecoder(strings.NewReader(artistsXML)).ForEach(func(a *discogs.Artist) error {
+		artists = append(artists, a)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to decode artists: %s", err)
+	}
+	if len(artists) != 1 {
+		t.Fatalf("artists got=%d; want=1", len(artists))
+	}
+	a := artists[0]
+	if a.Realname != "Jesper Dahlbäck" || len(a.Namevariations) != 1 || len(a.URLs) != 1 {
+		t.Errorf("artist got=%+v", a)
+	}
+	if len(a.Aliases) != 1 || a.Aliases[0].ID != 239 || len(a.Groups) != 1 || a.Groups[0].Name != "Pierre's Pfeiffer" {
+		t.Errorf("aliases got=%+v, groups got=%+v", a.Aliases, a.Groups)
+	}
+}
+
+func TestLabelDecoder(t *testing.T) {
+	const labelsXML = `<labels><label>
+		<id>1</id><name>Planet E</name><contactinfo>Planet E Communications</contactinfo>
+		<sublabels><label id="86537">Antidote (4)</label><label id="41841">Community Projects</label></sublabels>
+	</label><label><id>2</id><name>Earthtones Recordings</name></label></labels>`
+
+	d := NewLabelDecoder(strings.NewReader(labelsXML))
+	l, err := d.Next()
+	if err != nil {
+		t.Fatalf("failed to decode label: %s", err)
+	}
+	if l.Name != "Planet E" || len(l.Sublabels) != 2 || l.Sublabels[0].ID != 86537 {
+		t.Errorf("label got=%+v", l)
+	}
+	// sublabels are not decoded as labels of their own
+	if l, err = d.Next(); err != nil || l.ID != 2 {
+		t.Errorf("second label got=%+v, %v", l, err)
+	}
+}
+
+func TestMasterDecoder(t *testing.T) {
+	const mastersXML = `<masters><master id="18500"><main_release>155102</main_release><year>2001</year><title>New Soil</title><genres><genre>Electronic</genre></genres></master></masters>`
+
+	m, err := NewMasterDecoder(strings.NewReader(mastersXML)).Next()
+	if err != nil {
+		t.Fatalf("failed to decode master: %s", err)
+	}
+	if m.ID != 18500 || m.MainRelease != 155102 || m.Year != 2001 || m.Title != "New Soil" {
+		t.Errorf("master got=%+v", m)
+	}
+}
+
+func TestDecoderForEachStops(t *testing.T) {
+	stop := errors.New("stop")
+	var n int
+	err := NewReleaseDecoder(strings.NewReader(releasesXML)).ForEach(func(*discogs.Release) error {
+		n++
+		return stop
+	})
+	if err != stop || n != 1 {
+		t.Errorf("got err=%v after %d releases; want=%s after 1", err, n, stop)
+	}
+}
@@ -0,0 +1,275 @@
+package dumps
+
+import (
+	"strconv"
+
+	"github.com/hrfee/go-discogs"
+)
+
+// XML shapes of dump elements, converted to API models once decoded.
+
+type xmlImage struct {
+	Type   string `xml:"type,attr"`
+	URI    string `xml:"uri,attr"`
+	URI150 string `xml:"uri150,attr"`
+	Width  int    `xml:"width,attr"`
+	Height int    `xml:"height,attr"`
+}
+
+type xmlVideo struct {
+	Src         string `xml:"src,attr"`
+	Duration    int    `xml:"duration,attr"`
+	Embed       bool   `xml:"embed,attr"`
+	Title       string `xml:"title"`
+	Description string `xml:"description"`
+}
+
+type xmlArtistCredit struct {
+	ID     int    `xml:"id"`
+	Name   string `xml:"name"`
+	Anv    string `xml:"anv"`
+	Join   string `xml:"join"`
+	Role   string `xml:"role"`
+	Tracks string `xml:"tracks"`
+}
+
+// xmlRef is a name referring to another entity by id, e.g. an artist alias.
+type xmlRef struct {
+	ID   int    `xml:"id,attr"`
+	Name string `xml:",chardata"`
+}
+
+type xmlTrack struct {
+	Position     string            `xml:"position"`
+	Title        string            `xml:"title"`
+	Duration     string            `xml:"duration"`
+	Artists      []xmlArtistCredit `xml:"artists>artist"`
+	ExtraArtists []xmlArtistCredit `xml:"extraartists>artist"`
+}
+
+type xmlReleaseLabel struct {
+	ID    int    `xml:"id,attr"`
+	Name  string `xml:"name,attr"`
+	Catno string `xml:"catno,attr"`
+}
+
+type xmlFormat struct {
+	Name         string   `xml:"name,attr"`
+	Qty          string   `xml:"qty,attr"`
+	Text         string   `xml:"text,attr"`
+	Descriptions []string `xml:"descriptions>description"`
+}
+
+type xmlIdentifier struct {
+	Type        string `xml:"type,attr"`
+	Value       string `xml:"value,attr"`
+	Description string `xml:"description,attr"`
+}
+
+type xmlCompany struct {
+	ID             int    `xml:"id"`
+	Name           string `xml:"name"`
+	Catno          string `xml:"catno"`
+	EntityType     string `xml:"entity_type"`
+	EntityTypeName string `xml:"entity_type_name"`
+	ResourceURL    string `xml:"resource_url"`
+}
+
+type xmlRelease struct {
+	ID           int               `xml:"id,attr"`
+	Status       string            `xml:"status,attr"`
+	Title        string            `xml:"title"`
+	Images       []xmlImage        `xml:"images>image"`
+	Artists      []xmlArtistCredit `xml:"artists>artist"`
+	ExtraArtists []xmlArtistCredit `xml:"extraartists>artist"`
+	Labels       []xmlReleaseLabel `xml:"labels>label"`
+	Formats      []xmlFormat       `xml:"formats>format"`
+	Genres       []string          `xml:"genres>genre"`
+	Styles       []string          `xml:"styles>style"`
+	Country      string            `xml:"country"`
+	Released     string            `xml:"released"`
+	Notes        string            `xml:"notes"`
+	DataQuality  string            `xml:"data_quality"`
+	MasterID     int               `xml:"master_id"`
+	Tracklist    []xmlTrack        `xml:"tracklist>track"`
+	Identifiers  []xmlIdentifier   `xml:"identifiers>identifier"`
+	Videos       []xmlVideo        `xml:"videos>video"`
+	Companies    []xmlCompany      `xml:"companies>company"`
+}
+
+type xmlMaster struct {
+	ID          int               `xml:"id,attr"`
+	MainRelease int               `xml:"main_release"`
+	Images      []xmlImage        `xml:"images>image"`
+	Artists     []xmlArtistCredit `xml:"artists>artist"`
+	Genres      []string          `xml:"genres>genre"`
+	Styles      []string          `xml:"styles>style"`
+	Year        int               `xml:"year"`
+	Title       string            `xml:"title"`
+	Notes       string            `xml:"notes"`
+	DataQuality string            `xml:"data_quality"`
+	Videos      []xmlVideo        `xml:"videos>video"`
+}
+
+type xmlArtist struct {
+	ID             int        `xml:"id"`
+	Name           string     `xml:"name"`
+	RealName       string     `xml:"realname"`
+	Images         []xmlImage `xml:"images>image"`
+	Profile        string     `xml:"profile"`
+	DataQuality    string     `xml:"data_quality"`
+	URLs           []string   `xml:"urls>url"`
+	NameVariations []string   `xml:"namevariations>name"`
+	Aliases        []xmlRef   `xml:"aliases>name"`
+	Members        []xmlRef   `xml:"members>name"`
+	Groups         []xmlRef   `xml:"groups>name"`
+}
+
+type xmlLabel struct {
+	ID          int        `xml:"id"`
+	Name        string     `xml:"name"`
+	Images      []xmlImage `xml:"images>image"`
+	ContactInfo string     `xml:"contactinfo"`
+	Profile     string     `xml:"profile"`
+	DataQuality string     `xml:"data_quality"`
+	URLs        []string   `xml:"urls>url"`
+	Sublabels   []xmlRef   `xml:"sublabels>label"`
+}
+
+func (x *xmlRelease) release() *discogs.Release {
+	r := &discogs.Release{
+		ID:           x.ID,
+		Status:       x.Status,
+		Title:        x.Title,
+		Images:       images(x.Images),
+		Artists:      artistCredits(x.Artists),
+		ExtraArtists: artistCredits(x.ExtraArtists),
+		Genres:       x.Genres,
+		Styles:       x.Styles,
+		Country:      x.Country,
+		Released:     x.Released,
+		Notes:        x.Notes,
+		DataQuality:  x.DataQuality,
+		MasterID:     x.MasterID,
+		Tracklist:    tracks(x.Tracklist),
+		Videos:       videos(x.Videos),
+	}
+	if len(x.Released) >= 4 {
+		r.Year, _ = strconv.Atoi(x.Released[:4])
+	}
+	for _, l := range x.Labels {
+		r.Labels = append(r.Labels, discogs.LabelSource{ID: l.ID, Name: l.Name, Catno: l.Catno})
+	}
+	for _, f := range x.Formats {
+		r.Formats = append(r.Formats, discogs.Format{Name: f.Name, Qty: f.Qty, Text: f.Text, Descriptions: f.Descriptions})
+		qty, _ := strconv.Atoi(f.Qty)
+		r.FormatQuantity += qty
+	}
+	for _, i := range x.Identifiers {
+		r.Identifiers = append(r.Identifiers, discogs.Identifier{Type: i.Type, Value: i.Value, Description: i.Description})
+	}
+	for _, c := range x.Companies {
+		r.Companies = append(r.Companies, discogs.Company{
+			ID:             c.ID,
+			Name:           c.Name,
+			Catno:          c.Catno,
+			EntityType:     c.EntityType,
+			EntityTypeName: c.EntityTypeName,
+			ResourceURL:    c.ResourceURL,
+		})
+	}
+	return r
+}
+
+func (x *xmlMaster) master() *discogs.Master {
+	return &discogs.Master{
+		ID:          x.ID,
+		MainRelease: x.MainRelease,
+		Images:      images(x.Images),
+		Artists:     artistCredits(x.Artists),
+		Genres:      x.Genres,
+		Styles:      x.Styles,
+		Year:        x.Year,
+		Title:       x.Title,
+		Notes:       x.Notes,
+		DataQuality: x.DataQuality,
+		Videos:      videos(x.Videos),
+	}
+}
+
+func (x *xmlArtist) artist() *discogs.Artist {
+	a := &discogs.Artist{
+		ID:             x.ID,
+		Name:           x.Name,
+		Realname:       x.RealName,
+		Images:         images(x.Images),
+		Profile:        x.Profile,
+		DataQuality:    x.DataQuality,
+		URLs:           x.URLs,
+		Namevariations: x.NameVariations,
+	}
+	for _, ref := range x.Aliases {
+		a.Aliases = append(a.Aliases, discogs.Alias{ID: ref.ID, Name: ref.Name})
+	}
+	for _, ref := range x.Members {
+		a.Members = append(a.Members, discogs.Member{ID: ref.ID, Name: ref.Name})
+	}
+	for _, ref := range x.Groups {
+		a.Groups = append(a.Groups, discogs.Member{ID: ref.ID, Name: ref.Name})
+	}
+	return a
+}
+
+func (x *xmlLabel) label() *discogs.Label {
+	l := &discogs.Label{
+		ID:          x.ID,
+		Name:        x.Name,
+		Images:      images(x.Images),
+		ContactInfo: x.ContactInfo,
+		Profile:     x.Profile,
+		DataQuality: x.DataQuality,
+		URLs:        x.URLs,
+	}
+	for _, ref := range x.Sublabels {
+		l.Sublabels = append(l.Sublabels, discogs.Sublable{ID: ref.ID, Name: ref.Name})
+	}
+	return l
+}
+
+func images(xs []xmlImage) []discogs.Image {
+	var images []discogs.Image
+	for _, x := range xs {
+		images = append(images, discogs.Image{Type: x.Type, URI: x.URI, URI150: x.URI150, Width: x.Width, Height: x.Height})
+	}
+	return images
+}
+
+func videos(xs []xmlVideo) []discogs.Video {
+	var videos []discogs.Video
+	for _, x := range xs {
+		videos = append(videos, discogs.Video{URI: x.Src, Duration: x.Duration, Embed: x.Embed, Title: x.Title, Description: x.Description})
+	}
+	return videos
+}
+
+func artistCredits(xs []xmlArtistCredit) []discogs.ArtistSource {
+	var artists []discogs.ArtistSource
+	for _, x := range xs {
+		artists = append(artists, discogs.ArtistSource{ID: x.ID, Name: x.Name, Anv: x.Anv, Join: x.Join, Role: x.Role, Tracks: x.Tracks})
+	}
+	return artists
+}
+
+func tracks(xs []xmlTrack) []discogs.Track {
+	var tracks []discogs.Track
+	for _, x := range xs {
+		tracks = append(tracks, discogs.Track{
+			Position:     x.Position,
+			Title:        x.Title,
+			Duration:     x.Duration,
+			Artists:      artistCredits(x.Artists),
+			Extraartists: artistCredits(x.ExtraArtists),
+		})
+	}
+	return tracks
+}
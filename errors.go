@@ -1,7 +1,10 @@
 package discogs
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"strings"
 )
 
@@ -17,10 +20,82 @@ func (e *Error) Error() string {
 // APIErrors
 var (
 	ErrCurrencyNotSupported = &Error{"currency does not supported"}
+	ErrInvalidExportFormat  = &Error{"invalid export format"}
+	ErrInvalidExportID      = &Error{"invalid export id"}
+	ErrInvalidFieldID       = &Error{"invalid field id"}
+	ErrInvalidFolderID      = &Error{"invalid folder id"}
+	ErrInvalidFolderName    = &Error{"invalid folder name"}
+	ErrInvalidImageURL      = &Error{"invalid image url"}
+	ErrInvalidInstanceID    = &Error{"invalid instance id"}
+	ErrInvalidInterval      = &Error{"invalid interval"}
+	ErrInvalidListingID     = &Error{"invalid listing id"}
+	ErrInvalidMediaType     = &Error{"invalid media type"}
+	ErrInvalidOAuthToken    = &Error{"invalid oauth token"}
+	ErrInvalidOrderID       = &Error{"invalid order id"}
+	ErrInvalidOrderMessage  = &Error{"order message or status required"}
+	ErrInvalidRating        = &Error{"invalid rating"}
 	ErrInvalidReleaseID     = &Error{"invalid release id"}
 	ErrInvalidSortKey       = &Error{"invalid sort key"}
+	ErrInvalidSortOrder     = &Error{"invalid sort order"}
+	ErrInvalidToken         = &Error{"invalid token"}
+	ErrInvalidUploadID      = &Error{"invalid upload id"}
 	ErrInvalidUsername      = &Error{"invalid username"}
+	ErrNoMorePages          = &Error{"no more pages"}
+	ErrOAuthRequired        = &Error{"oauth consumer key and secret required"}
 	ErrTooManyRequests      = &Error{"too many requests"}
 	ErrUnauthorized         = &Error{"authentication required"}
 	ErrUserAgentInvalid     = &Error{"invalid user-agent"}
 )
+
+// maxErrorBodySize limits how much of an error response is kept.
+const maxErrorBodySize = 64 << 10
+
+// APIError is an unsuccessful response of Discogs API.
+// Use errors.As to inspect it, errors.Is(err, ErrUnauthorized) and
+// errors.Is(err, ErrTooManyRequests) still match corresponding statuses.
+type APIError struct {
+	// StatusCode is HTTP status code of the response.
+	StatusCode int
+	// Message is the message discogs returned, if any.
+	Message string
+	// Body is the raw response body.
+	Body []byte
+}
+
+func (e *APIError) Error() string {
+	message := e.Message
+	if message == "" {
+		message = http.StatusText(e.StatusCode)
+	}
+	return fmt.Sprintf("discogs error: %d %s", e.StatusCode, strings.ToLower(message))
+}
+
+func (e *APIError) Unwrap() error {
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusTooManyRequests:
+		return ErrTooManyRequests
+	}
+	return nil
+}
+
+// newAPIError reads error response body.
+func newAPIError(response *http.Response) error {
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxErrorBodySize))
+	if err != nil {
+		return err
+	}
+
+	var message struct {
+		Message string `json:"message"`
+	}
+	// body is not always JSON, message stays empty then
+	_ = json.Unmarshal(body, &message)
+
+	return &APIError{
+		StatusCode: response.StatusCode,
+		Message:    message.Message,
+		Body:       body,
+	}
+}
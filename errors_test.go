@@ -0,0 +1,73 @@
+package discogs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		message string
+		text    string
+		is      error
+	}{
+		"not found": {
+			status:  http.StatusNotFound,
+			body:    `{"message": "Release not found."}`,
+			message: "Release not found.",
+			text:    "discogs error: 404 release not found.",
+		},
+		"unauthorized": {
+			status:  http.StatusUnauthorized,
+			body:    `{"message": "You must authenticate to access this resource."}`,
+			message: "You must authenticate to access this resource.",
+			text:    "discogs error: 401 you must authenticate to access this resource.",
+			is:      ErrUnauthorized,
+		},
+		"not json": {
+			status: http.StatusBadGateway,
+			body:   "<html>Bad Gateway</html>",
+			text:   "discogs error: 502 bad gateway",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = io.WriteString(w, tt.body)
+			}))
+			defer ts.Close()
+
+			d := initDiscogsClient(t, &Options{URL: ts.URL})
+			_, err := d.Release(context.Background(), 1)
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("err got=%v; want *APIError", err)
+			}
+			if apiErr.StatusCode != tt.status {
+				t.Errorf("status got=%d; want=%d", apiErr.StatusCode, tt.status)
+			}
+			if apiErr.Message != tt.message {
+				t.Errorf("message got=%q; want=%q", apiErr.Message, tt.message)
+			}
+			if string(apiErr.Body) != tt.body {
+				t.Errorf("body got=%q; want=%q", apiErr.Body, tt.body)
+			}
+			if err.Error() != tt.text {
+				t.Errorf("error got=%q; want=%q", err.Error(), tt.text)
+			}
+			if tt.is != nil && !errors.Is(err, tt.is) {
+				t.Errorf("errors.Is(%v, %v) = false", err, tt.is)
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 module github.com/hrfee/go-discogs
 
-go 1.17
+go 1.18
 
 require github.com/google/go-cmp v0.5.6
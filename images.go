@@ -0,0 +1,68 @@
+package discogs
+
+import (
+	"context"
+	"io"
+	"net/url"
+)
+
+// ImagesService is an interface to download images.
+type ImagesService interface {
+	// DownloadImage writes the image at imageURL to w.
+	// The imageURL is an image URI returned by discogs, e.g. Image.URI of a release.
+	// The request counts against the rate limit. It is sent with the client's credentials
+	// only if imageURL is a https URL of discogs or the API endpoint set by Options.URL,
+	// images of other hosts are downloaded without credentials.
+	DownloadImage(ctx context.Context, imageURL string, w io.Writer) error
+}
+
+// imageHosts are hosts of discogs images which may be sent credentials.
+var imageHosts = map[string]bool{
+	"api.discogs.com": true,
+	"i.discogs.com":   true,
+}
+
+type imagesService struct {
+	*client
+	url string
+}
+
+func newImagesService(c *client, url string) ImagesService {
+	return &imagesService{client: c, url: url}
+}
+
+func (s *imagesService) DownloadImage(ctx context.Context, imageURL string, w io.Writer) error {
+	u, err := url.Parse(imageURL)
+	if err != nil {
+		return err
+	}
+	if !u.IsAbs() {
+		return ErrInvalidImageURL
+	}
+	if !s.trusted(u) {
+		ctx = withoutCredentials(ctx)
+	}
+	// the query is sent as is, re-encoding it would break signed image URLs
+	r, err := s.newRequest(ctx, "GET", imageURL, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := s.do(r)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	_, err = io.Copy(w, response.Body)
+	return err
+}
+
+// trusted reports whether credentials may be sent to u.
+func (s *imagesService) trusted(u *url.URL) bool {
+	if u.Scheme == "https" && imageHosts[u.Host] {
+		return true
+	}
+	api, err := url.Parse(s.url)
+	return err == nil && u.Scheme == api.Scheme && u.Host == api.Host
+}
@@ -0,0 +1,121 @@
+package discogs
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestImagesServiceDownloadImage(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/images/R-8138518-1.jpeg" || r.URL.Query().Get("size") != "600" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Authorization") != "Discogs token=image-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set(rateLimitTotalHeader, "60")
+		w.Header().Set(rateLimitUsedHeader, "1")
+		w.Header().Set(rateLimitRemainingHeader, "59")
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, "jpeg")
+	}))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL, Token: "image-token"})
+
+	var buf bytes.Buffer
+	if err := d.DownloadImage(context.Background(), ts.URL+"/images/R-8138518-1.jpeg?size=600", &buf); err != nil {
+		t.Fatalf("failed to download image: %s", err)
+	}
+	if got := buf.String(); got != "jpeg" {
+		t.Errorf("image got=%q; want=%q", got, "jpeg")
+	}
+	if got := d.RateLimit().Remaining; got != 59 {
+		t.Errorf("rate limit remaining got=%d; want=%d", got, 59)
+	}
+}
+
+func TestImagesServiceDownloadImageForeignHost(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer api.Close()
+	foreign := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("foreign host got authorization %q", r.Header.Get("Authorization"))
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, "jpeg")
+	}))
+	defer foreign.Close()
+
+	clients := map[string]Discogs{
+		"token": initDiscogsClient(t, &Options{URL: api.URL, Token: "image-token"}),
+		"oauth": initDiscogsClient(t, &Options{URL: api.URL, OAuth: &OAuth{ConsumerKey: "key", ConsumerSecret: "secret", Token: "token", TokenSecret: "secret"}}),
+	}
+	for name, d := range clients {
+		for _, ctx := range []context.Context{context.Background(), WithToken(context.Background(), "user-token")} {
+			var buf bytes.Buffer
+			if err := d.DownloadImage(ctx, foreign.URL+"/images/R-1.jpeg", &buf); err != nil {
+				t.Fatalf("%s: failed to download image: %s", name, err)
+			}
+			if got := buf.String(); got != "jpeg" {
+				t.Errorf("%s: image got=%q; want=%q", name, got, "jpeg")
+			}
+		}
+	}
+}
+
+func TestImagesServiceDownloadImageKeepsQuery(t *testing.T) {
+	var got []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.RequestURI)
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, "jpeg")
+	}))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+	// signed image URLs must reach the server exactly as discogs returned them
+	want := []string{
+		"/images/R-8138518-1.jpeg?sig=a%2Fb%3D&expires=1&path=%7Efoo",
+		"/images/R-8138518-1.jpeg",
+	}
+	for _, uri := range want {
+		if err := d.DownloadImage(context.Background(), ts.URL+uri, io.Discard); err != nil {
+			t.Fatalf("failed to download image: %s", err)
+		}
+	}
+	if len(got) != len(want) {
+		t.Fatalf("requests got=%q; want=%q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("request uri got=%s; want=%s", got[i], want[i])
+		}
+	}
+}
+
+func TestImagesServiceDownloadImageErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, nil)
+	ctx := context.Background()
+
+	if err := d.DownloadImage(ctx, "/images/R-1.jpeg", io.Discard); err != ErrInvalidImageURL {
+		t.Errorf("err got=%v; want=%s", err, ErrInvalidImageURL)
+	}
+	var buf bytes.Buffer
+	if err := d.DownloadImage(ctx, ts.URL+"/images/R-1.jpeg", &buf); err == nil {
+		t.Error("expected error for missing image")
+	}
+}
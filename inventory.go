@@ -0,0 +1,174 @@
+package discogs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+)
+
+// InventoryService is an interface to work with seller inventory exports and uploads.
+// Authentication as the seller is required.
+type InventoryService interface {
+	// ExportInventory requests an export of the seller’s inventory as CSV
+	// and returns ID of the export.
+	ExportInventory(ctx context.Context) (int, error)
+	// InventoryExports returns a list of recent exports.
+	InventoryExports(ctx context.Context, pagination *Pagination) (*InventoryExports, error)
+	// InventoryExport returns an export by its ID.
+	InventoryExport(ctx context.Context, exportID int) (*InventoryExport, error)
+	// DownloadInventoryExport writes CSV of a finished export to w.
+	DownloadInventoryExport(ctx context.Context, exportID int, w io.Writer) error
+	// UploadInventoryAdd creates listings from CSV and returns ID of the upload.
+	UploadInventoryAdd(ctx context.Context, csv io.Reader) (int, error)
+	// UploadInventoryChange changes listings from CSV and returns ID of the upload.
+	UploadInventoryChange(ctx context.Context, csv io.Reader) (int, error)
+	// UploadInventoryDelete deletes listings from CSV and returns ID of the upload.
+	UploadInventoryDelete(ctx context.Context, csv io.Reader) (int, error)
+	// InventoryUploads returns a list of recent uploads.
+	InventoryUploads(ctx context.Context, pagination *Pagination) (*InventoryUploads, error)
+	// InventoryUpload returns an upload by its ID.
+	InventoryUpload(ctx context.Context, uploadID int) (*InventoryUpload, error)
+}
+
+type inventoryService struct {
+	*client
+	url string
+}
+
+func newInventoryService(c *client, url string) InventoryService {
+	return &inventoryService{
+		client: c,
+		url:    url,
+	}
+}
+
+// InventoryExport describes an export of seller’s inventory.
+type InventoryExport struct {
+	ID          int    `json:"id"`
+	Status      string `json:"status"`
+	Filename    string `json:"filename"`
+	CreatedTS   string `json:"created_ts"`
+	FinishedTS  string `json:"finished_ts"`
+	URL         string `json:"url"`
+	DownloadURL string `json:"download_url"`
+}
+
+// InventoryExports is a list of recent inventory exports.
+type InventoryExports struct {
+	Pagination Page              `json:"pagination"`
+	Items      []InventoryExport `json:"items"`
+}
+
+func (s *inventoryService) ExportInventory(ctx context.Context) (int, error) {
+	r, err := s.newRequest(ctx, "POST", s.url+"/export", nil, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.doLocation(r, ErrInvalidExportID)
+}
+
+func (s *inventoryService) InventoryExports(ctx context.Context, pagination *Pagination) (*InventoryExports, error) {
+	var exports *InventoryExports
+	err := s.request(ctx, s.url+"/export", pagination.params(), &exports)
+	return exports, err
+}
+
+func (s *inventoryService) InventoryExport(ctx context.Context, exportID int) (*InventoryExport, error) {
+	if exportID == 0 {
+		return nil, ErrInvalidExportID
+	}
+	var export *InventoryExport
+	err := s.request(ctx, s.url+"/export/"+strconv.Itoa(exportID), nil, &export)
+	return export, err
+}
+
+func (s *inventoryService) DownloadInventoryExport(ctx context.Context, exportID int, w io.Writer) error {
+	if exportID == 0 {
+		return ErrInvalidExportID
+	}
+
+	r, err := s.newRequest(ctx, "GET", s.url+"/export/"+strconv.Itoa(exportID)+"/download", nil, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := s.do(r)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	_, err = io.Copy(w, response.Body)
+	return err
+}
+
+// InventoryUpload describes an upload of seller’s inventory.
+type InventoryUpload struct {
+	ID         int    `json:"id"`
+	Type       string `json:"type"` // one of add, change, delete
+	Status     string `json:"status"`
+	Results    string `json:"results"`
+	Filename   string `json:"filename"`
+	CreatedTS  string `json:"created_ts"`
+	FinishedTS string `json:"finished_ts"`
+}
+
+// InventoryUploads is a list of recent inventory uploads.
+type InventoryUploads struct {
+	Pagination Page              `json:"pagination"`
+	Items      []InventoryUpload `json:"items"`
+}
+
+func (s *inventoryService) UploadInventoryAdd(ctx context.Context, csv io.Reader) (int, error) {
+	return s.upload(ctx, "add", csv)
+}
+
+func (s *inventoryService) UploadInventoryChange(ctx context.Context, csv io.Reader) (int, error) {
+	return s.upload(ctx, "change", csv)
+}
+
+func (s *inventoryService) UploadInventoryDelete(ctx context.Context, csv io.Reader) (int, error) {
+	return s.upload(ctx, "delete", csv)
+}
+
+func (s *inventoryService) upload(ctx context.Context, kind string, csv io.Reader) (int, error) {
+	r, err := s.newMultipartRequest(ctx, "POST", s.url+"/upload/"+kind, "upload", "inventory.csv", csv)
+	if err != nil {
+		return 0, err
+	}
+	return s.doLocation(r, ErrInvalidUploadID)
+}
+
+func (s *inventoryService) InventoryUploads(ctx context.Context, pagination *Pagination) (*InventoryUploads, error) {
+	var uploads *InventoryUploads
+	err := s.request(ctx, s.url+"/upload", pagination.params(), &uploads)
+	return uploads, err
+}
+
+func (s *inventoryService) InventoryUpload(ctx context.Context, uploadID int) (*InventoryUpload, error) {
+	if uploadID == 0 {
+		return nil, ErrInvalidUploadID
+	}
+	var upload *InventoryUpload
+	err := s.request(ctx, s.url+"/upload/"+strconv.Itoa(uploadID), nil, &upload)
+	return upload, err
+}
+
+// doLocation sends the request and returns ID from Location header of the response,
+// e.g. https://api.discogs.com/inventory/export/{id}. invalid is returned if there is no ID.
+func (s *inventoryService) doLocation(r *http.Request, invalid error) (int, error) {
+	response, err := s.do(r)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	id, err := strconv.Atoi(path.Base(response.Header.Get("Location")))
+	if err != nil {
+		return 0, invalid
+	}
+	return id, nil
+}
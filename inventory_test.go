@@ -0,0 +1,215 @@
+package discogs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const (
+	testExportID = 599632
+	testUploadID = 119615
+)
+
+func InventoryServer(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/inventory/export":
+		switch r.Method {
+		case "POST":
+			w.Header().Set("Location", "https://api.discogs.com/inventory/export/599632")
+			w.WriteHeader(http.StatusOK)
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			if _, err := io.WriteString(w, inventoryExportsJson); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+
+	case "/inventory/export/599632":
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, inventoryExportJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+	case "/inventory/export/599632/download":
+		w.Header().Set("Content-Type", "text/csv")
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, inventoryExportCSV); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+	case "/inventory/upload/add", "/inventory/upload/change", "/inventory/upload/delete":
+		if r.Method != "POST" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		file, _, err := r.FormFile("upload")
+		if err != nil {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		defer file.Close()
+		if body, err := io.ReadAll(file); err != nil || string(body) != inventoryUploadCSV {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		w.Header().Set("Location", "https://api.discogs.com/inventory/upload/119615")
+		w.WriteHeader(http.StatusOK)
+
+	case "/inventory/upload":
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, inventoryUploadsJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+	case "/inventory/upload/119615":
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, inventoryUploadJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestInventoryServiceExportInventory(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(InventoryServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	id, err := d.ExportInventory(context.Background())
+	if err != nil {
+		t.Fatalf("failed to export inventory: %s", err)
+	}
+	if id != testExportID {
+		t.Errorf("export id got=%d; want=%d", id, testExportID)
+	}
+}
+
+func TestInventoryServiceInventoryExports(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(InventoryServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	exports, err := d.InventoryExports(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("failed to get inventory exports: %s", err)
+	}
+
+	json, err := json.Marshal(exports)
+	if err != nil {
+		t.Fatalf("failed to marshal inventory exports: %s", err)
+	}
+
+	compareJson(t, string(json), inventoryExportsJson)
+}
+
+func TestInventoryServiceInventoryExport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(InventoryServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	export, err := d.InventoryExport(context.Background(), testExportID)
+	if err != nil {
+		t.Fatalf("failed to get inventory export: %s", err)
+	}
+
+	json, err := json.Marshal(export)
+	if err != nil {
+		t.Fatalf("failed to marshal inventory export: %s", err)
+	}
+
+	compareJson(t, string(json), inventoryExportJson)
+}
+
+func TestInventoryServiceDownloadInventoryExport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(InventoryServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	var buf bytes.Buffer
+	if err := d.DownloadInventoryExport(context.Background(), testExportID, &buf); err != nil {
+		t.Fatalf("failed to download inventory export: %s", err)
+	}
+	if buf.String() != inventoryExportCSV {
+		t.Errorf("csv got=%q; want=%q", buf.String(), inventoryExportCSV)
+	}
+}
+
+func TestInventoryServiceUploadInventory(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(InventoryServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+	ctx := context.Background()
+
+	uploads := map[string]func(context.Context, io.Reader) (int, error){
+		"add":    d.UploadInventoryAdd,
+		"change": d.UploadInventoryChange,
+		"delete": d.UploadInventoryDelete,
+	}
+	for name, upload := range uploads {
+		id, err := upload(ctx, strings.NewReader(inventoryUploadCSV))
+		if err != nil {
+			t.Fatalf("%s: failed to upload inventory: %s", name, err)
+		}
+		if id != testUploadID {
+			t.Errorf("%s: upload id got=%d; want=%d", name, id, testUploadID)
+		}
+	}
+}
+
+func TestInventoryServiceInventoryUploads(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(InventoryServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	uploads, err := d.InventoryUploads(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("failed to get inventory uploads: %s", err)
+	}
+
+	json, err := json.Marshal(uploads)
+	if err != nil {
+		t.Fatalf("failed to marshal inventory uploads: %s", err)
+	}
+
+	compareJson(t, string(json), inventoryUploadsJson)
+}
+
+func TestInventoryServiceInventoryUpload(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(InventoryServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	upload, err := d.InventoryUpload(context.Background(), testUploadID)
+	if err != nil {
+		t.Fatalf("failed to get inventory upload: %s", err)
+	}
+
+	json, err := json.Marshal(upload)
+	if err != nil {
+		t.Fatalf("failed to marshal inventory upload: %s", err)
+	}
+
+	compareJson(t, string(json), inventoryUploadJson)
+}
@@ -0,0 +1,94 @@
+package discogs
+
+import (
+	"context"
+	"strconv"
+)
+
+// ListsService is an interface to work with user lists.
+type ListsService interface {
+	// UserLists returns a user’s lists.
+	// Private lists are returned only to the lists owner.
+	UserLists(ctx context.Context, username string, pagination *Pagination) (*UserLists, error)
+	// UserListsPager iterates over all pages of a user’s lists.
+	UserListsPager(username string, pagination *Pagination) *Pager[ListSummary]
+	// List returns items of a list.
+	// Authentication as the list owner is required if the list is private.
+	List(ctx context.Context, listID int) (*List, error)
+}
+
+type listsService struct {
+	*client
+	url string
+}
+
+func newListsService(c *client, url string) ListsService {
+	return &listsService{
+		client: c,
+		url:    url,
+	}
+}
+
+// ListSummary is a list in a user’s lists.
+type ListSummary struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Public      bool   `json:"public"`
+	DateAdded   string `json:"date_added"`
+	DateChanged string `json:"date_changed"`
+	ResourceURL string `json:"resource_url"`
+	URI         string `json:"uri"`
+}
+
+// UserLists is a list of a user’s lists.
+type UserLists struct {
+	Pagination Page          `json:"pagination"`
+	Lists      []ListSummary `json:"lists"`
+}
+
+func (s *listsService) UserLists(ctx context.Context, username string, pagination *Pagination) (*UserLists, error) {
+	if username == "" {
+		return nil, ErrInvalidUsername
+	}
+	var lists *UserLists
+	err := s.request(ctx, s.url+"/users/"+username+"/lists", pagination.params(), &lists)
+	return lists, err
+}
+
+func (s *listsService) UserListsPager(username string, pagination *Pagination) *Pager[ListSummary] {
+	if username == "" {
+		return errPager[ListSummary](ErrInvalidUsername)
+	}
+	return newPager[ListSummary](s.client, s.url+"/users/"+username+"/lists", pagination.params(), "lists")
+}
+
+// List is a user-curated list of releases, masters, artists and labels.
+type List struct {
+	ID          int        `json:"list_id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Public      bool       `json:"public"`
+	CreatedTS   string     `json:"created_ts"`
+	ModifiedTS  string     `json:"modified_ts"`
+	URL         string     `json:"url"`
+	ResourceURL string     `json:"resource_url"`
+	Items       []ListItem `json:"items"`
+}
+
+// ListItem is an entity in a list.
+type ListItem struct {
+	ID           int    `json:"id"`
+	Type         string `json:"type"` // one of release, master, artist, label
+	Comment      string `json:"comment"`
+	DisplayTitle string `json:"display_title"`
+	ImageURL     string `json:"image_url"`
+	ResourceURL  string `json:"resource_url"`
+	URI          string `json:"uri"`
+}
+
+func (s *listsService) List(ctx context.Context, listID int) (*List, error) {
+	var list *List
+	err := s.request(ctx, s.url+"/lists/"+strconv.Itoa(listID), nil, &list)
+	return list, err
+}
@@ -0,0 +1,74 @@
+package discogs
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func ListsServer(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/users/" + testUsername + "/lists":
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, userListsJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+	case "/lists/2":
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, listJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestListsServiceUserLists(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(ListsServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	lists, err := d.UserLists(context.Background(), testUsername, nil)
+	if err != nil {
+		t.Fatalf("failed to get user lists: %s", err)
+	}
+
+	json, err := json.Marshal(lists)
+	if err != nil {
+		t.Fatalf("failed to marshal user lists: %s", err)
+	}
+
+	compareJson(t, string(json), userListsJson)
+}
+
+func TestListsServiceList(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(ListsServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	list, err := d.List(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("failed to get list: %s", err)
+	}
+
+	json, err := json.Marshal(list)
+	if err != nil {
+		t.Fatalf("failed to marshal list: %s", err)
+	}
+
+	compareJson(t, string(json), listJson)
+}
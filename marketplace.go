@@ -1,32 +1,65 @@
 package discogs
 
 import (
+	"context"
 	"net/url"
 	"strconv"
+	"time"
 )
 
 const (
+	feeURI              = "/fee/"
+	listingsURI         = "/listings"
 	priceSuggestionsURI = "/price_suggestions/"
 	releaseStatsURI     = "/stats/"
 )
 
 type marketPlaceService struct {
+	*client
 	url      string
-	currency string
+	usersURL string
+	currency Currency
 }
 
 type MarketPlaceService interface {
 	// The best price suggestions according to grading
 	// Authentication is required.
-	PriceSuggestions(releaseID int) (*PriceListing, error)
+	PriceSuggestions(ctx context.Context, releaseID int) (*PriceListing, error)
+	// Fee calculates the marketplace fee for an item sold for price in the client's currency
+	// or the one passed WithCurrency.
+	// Authentication is optional.
+	Fee(ctx context.Context, price float64, opts ...CallOption) (*Listing, error)
 	// Short summary of marketplace listings
+	// Prices are in the client's currency unless WithCurrency is passed.
+	// Authentication is optional.
+	ReleaseStatistics(ctx context.Context, releaseID int, opts ...CallOption) (*Stats, error)
+	// Inventory returns listings of a seller.
+	// Listings which aren't for sale are returned only to the seller.
+	// Authentication is optional.
+	Inventory(ctx context.Context, username string, pagination *Pagination) (*Inventory, error)
+	// InventoryPager iterates over all pages of a seller’s listings.
+	InventoryPager(username string, pagination *Pagination) *Pager[InventoryListing]
+	// CreateListing creates a marketplace listing.
+	// Authentication as a seller is required.
+	CreateListing(ctx context.Context, listing *ListingRequest) (*NewListing, error)
+	// EditListing changes a marketplace listing.
+	// Authentication as the listing owner is required.
+	EditListing(ctx context.Context, listingID int, listing *ListingRequest) error
+	// DeleteListing removes a marketplace listing.
+	// Authentication as the listing owner is required.
+	DeleteListing(ctx context.Context, listingID int) error
+	// WatchReleaseListings queries statistics of a release every interval
+	// and emits events when new copies go on sale, the lowest price drops or the release sells out.
+	// The channel is closed once ctx is done.
 	// Authentication is optional.
-	ReleaseStatistics(releaseID int) (*Stats, error)
+	WatchReleaseListings(ctx context.Context, releaseID int, interval time.Duration, opts ...CallOption) (<-chan ListingEvent, error)
 }
 
-func newMarketPlaceService(url string, currency string) MarketPlaceService {
+func newMarketPlaceService(c *client, url string, usersURL string, currency Currency) MarketPlaceService {
 	return &marketPlaceService{
+		client:   c,
 		url:      url,
+		usersURL: usersURL,
 		currency: currency,
 	}
 }
@@ -56,17 +89,184 @@ type Stats struct {
 	Blocked     bool     `json:"blocked_from_sale"`
 }
 
-func (s *marketPlaceService) ReleaseStatistics(releaseID int) (*Stats, error) {
+func (s *marketPlaceService) ReleaseStatistics(ctx context.Context, releaseID int, opts ...CallOption) (*Stats, error) {
+	cur, err := s.callCurrency(s.currency, opts)
+	if err != nil {
+		return nil, err
+	}
 	params := url.Values{}
-	params.Set("curr_abbr", s.currency)
+	params.Set("curr_abbr", string(cur))
 
 	var stats *Stats
-	err := request(s.url+releaseStatsURI+strconv.Itoa(releaseID), params, &stats)
+	err = s.request(ctx, s.url+releaseStatsURI+strconv.Itoa(releaseID), params, &stats)
 	return stats, err
 }
 
-func (s *marketPlaceService) PriceSuggestions(releaseID int) (*PriceListing, error) {
+func (s *marketPlaceService) Fee(ctx context.Context, price float64, opts ...CallOption) (*Listing, error) {
+	cur, err := s.callCurrency(s.currency, opts)
+	if err != nil {
+		return nil, err
+	}
+	var fee *Listing
+	err = s.request(ctx, s.url+feeURI+strconv.FormatFloat(price, 'f', 2, 64)+"/"+string(cur), nil, &fee)
+	return fee, err
+}
+
+func (s *marketPlaceService) PriceSuggestions(ctx context.Context, releaseID int) (*PriceListing, error) {
 	var listings *PriceListing
-	err := request(s.url+priceSuggestionsURI+strconv.Itoa(releaseID), nil, &listings)
+	err := s.request(ctx, s.url+priceSuggestionsURI+strconv.Itoa(releaseID), nil, &listings)
 	return listings, err
 }
+
+// Condition is a media or sleeve condition of an item.
+type Condition string
+
+// Conditions used in the marketplace.
+// Generic, Not Graded and No Cover are valid sleeve conditions only.
+const (
+	ConditionMint         Condition = "Mint (M)"
+	ConditionNearMint     Condition = "Near Mint (NM or M-)"
+	ConditionVeryGoodPlus Condition = "Very Good Plus (VG+)"
+	ConditionVeryGood     Condition = "Very Good (VG)"
+	ConditionGoodPlus     Condition = "Good Plus (G+)"
+	ConditionGood         Condition = "Good (G)"
+	ConditionFair         Condition = "Fair (F)"
+	ConditionPoor         Condition = "Poor (P)"
+	ConditionGeneric      Condition = "Generic"
+	ConditionNotGraded    Condition = "Not Graded"
+	ConditionNoCover      Condition = "No Cover"
+)
+
+// ListingStatus is a status of a marketplace listing.
+type ListingStatus string
+
+// Statuses a listing can be created or edited with.
+const (
+	ListingForSale ListingStatus = "For Sale"
+	ListingDraft   ListingStatus = "Draft"
+)
+
+// ListingRequest describes a marketplace listing to create or edit.
+type ListingRequest struct {
+	ReleaseID       int           `json:"release_id"`                 // release the listing is for
+	Condition       Condition     `json:"condition"`                  // media condition
+	SleeveCondition Condition     `json:"sleeve_condition,omitempty"` // sleeve condition (optional)
+	Price           float64       `json:"price"`                      // price in the seller’s currency
+	Comments        string        `json:"comments,omitempty"`         // remarks about the item (optional)
+	AllowOffers     bool          `json:"allow_offers,omitempty"`     // allow buyers to make offers (optional)
+	Status          ListingStatus `json:"status"`                     // ListingForSale or ListingDraft
+	ExternalID      string        `json:"external_id,omitempty"`      // private seller’s notes (optional)
+	Location        string        `json:"location,omitempty"`         // private location of the item (optional)
+	Weight          int           `json:"weight,omitempty"`           // weight in grams (optional, calculated by default)
+	FormatQuantity  int           `json:"format_quantity,omitempty"`  // number of items for shipping (optional, calculated by default)
+}
+
+// NewListing is a created marketplace listing.
+type NewListing struct {
+	ListingID   int    `json:"listing_id"`
+	ResourceURL string `json:"resource_url"`
+}
+
+func (s *marketPlaceService) CreateListing(ctx context.Context, listing *ListingRequest) (*NewListing, error) {
+	if listing == nil || listing.ReleaseID == 0 {
+		return nil, ErrInvalidReleaseID
+	}
+	var l *NewListing
+	err := s.requestWithJSONBody(ctx, "POST", s.url+listingsURI, nil, listing, &l)
+	return l, err
+}
+
+func (s *marketPlaceService) EditListing(ctx context.Context, listingID int, listing *ListingRequest) error {
+	if listingID == 0 {
+		return ErrInvalidListingID
+	}
+	if listing == nil || listing.ReleaseID == 0 {
+		return ErrInvalidReleaseID
+	}
+	return s.requestWithJSONBody(ctx, "POST", s.url+listingsURI+"/"+strconv.Itoa(listingID), nil, listing, nil)
+}
+
+func (s *marketPlaceService) DeleteListing(ctx context.Context, listingID int) error {
+	if listingID == 0 {
+		return ErrInvalidListingID
+	}
+	return s.requestWithMethod(ctx, "DELETE", s.url+listingsURI+"/"+strconv.Itoa(listingID), nil, nil)
+}
+
+// InventorySeller is a seller of a listing.
+type InventorySeller struct {
+	ID          int    `json:"id"`
+	Username    string `json:"username"`
+	ResourceURL string `json:"resource_url"`
+}
+
+// InventoryRelease is a release a listing is for.
+type InventoryRelease struct {
+	ID            int    `json:"id"`
+	Description   string `json:"description"`
+	Artist        string `json:"artist"`
+	Title         string `json:"title"`
+	Format        string `json:"format"`
+	CatalogNumber string `json:"catalog_number"`
+	Year          int    `json:"year"`
+	Thumbnail     string `json:"thumbnail"`
+	ResourceURL   string `json:"resource_url"`
+}
+
+// InventoryListing is a listing of a seller’s inventory.
+type InventoryListing struct {
+	ID              int              `json:"id"`
+	Status          ListingStatus    `json:"status"`
+	Price           *Listing         `json:"price"`
+	AllowOffers     bool             `json:"allow_offers"`
+	Condition       Condition        `json:"condition"`
+	SleeveCondition Condition        `json:"sleeve_condition"`
+	Comments        string           `json:"comments"`
+	Posted          string           `json:"posted"`
+	ShipsFrom       string           `json:"ships_from"`
+	Audio           bool             `json:"audio"`
+	URI             string           `json:"uri"`
+	ResourceURL     string           `json:"resource_url"`
+	Seller          InventorySeller  `json:"seller"`
+	Release         InventoryRelease `json:"release"`
+}
+
+// Inventory is a page of a seller’s listings.
+type Inventory struct {
+	Pagination Page               `json:"pagination"`
+	Listings   []InventoryListing `json:"listings"`
+}
+
+var validInventorySort = newSortKeys(
+	SortByListed,
+	SortByPrice,
+	SortByItem,
+	SortByArtist,
+	SortByLabel,
+	SortByCatno,
+	SortByAudio,
+	SortByStatus,
+	SortByLocation,
+)
+
+func (s *marketPlaceService) Inventory(ctx context.Context, username string, pagination *Pagination) (*Inventory, error) {
+	if username == "" {
+		return nil, ErrInvalidUsername
+	}
+	if err := pagination.validate(validInventorySort); err != nil {
+		return nil, err
+	}
+	var inventory *Inventory
+	err := s.request(ctx, s.usersURL+"/"+username+"/inventory", pagination.params(), &inventory)
+	return inventory, err
+}
+
+func (s *marketPlaceService) InventoryPager(username string, pagination *Pagination) *Pager[InventoryListing] {
+	if username == "" {
+		return errPager[InventoryListing](ErrInvalidUsername)
+	}
+	if err := pagination.validate(validInventorySort); err != nil {
+		return errPager[InventoryListing](err)
+	}
+	return newPager[InventoryListing](s.client, s.usersURL+"/"+username+"/inventory", pagination.params(), "listings")
+}
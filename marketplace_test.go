@@ -1,12 +1,16 @@
 package discogs
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strconv"
+	"sync"
 	"testing"
+	"time"
 )
 
 const testReleaseID = 9893847
@@ -25,6 +29,13 @@ func MarketplaceServer(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+	case "/marketplace" + feeURI + "10.00/USD":
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, feeJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
 	case "/marketplace" + releaseStatsURI + strconv.Itoa(testReleaseID):
 		w.WriteHeader(http.StatusOK)
 		if _, err := io.WriteString(w, releaseStatsJson); err != nil {
@@ -43,7 +54,7 @@ func TestMarketplacePriceSuggestions(t *testing.T) {
 
 	d := initDiscogsClient(t, &Options{URL: ts.URL})
 
-	suggestion, err := d.PriceSuggestions(testReleaseID)
+	suggestion, err := d.PriceSuggestions(context.Background(), testReleaseID)
 	if err != nil {
 		t.Fatalf("failed to get price suggestion: %s", err)
 	}
@@ -56,13 +67,32 @@ func TestMarketplacePriceSuggestions(t *testing.T) {
 	compareJson(t, string(json), priceSuggestionJson)
 }
 
+func TestMarketplaceFee(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(MarketplaceServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	fee, err := d.Fee(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("failed to get fee: %s", err)
+	}
+
+	json, err := json.Marshal(fee)
+	if err != nil {
+		t.Fatalf("failed to marshal fee: %s", err)
+	}
+
+	compareJson(t, string(json), feeJson)
+}
+
 func TestMarketplaceReleaseStatistics(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(MarketplaceServer))
 	defer ts.Close()
 
 	d := initDiscogsClient(t, &Options{URL: ts.URL})
 
-	stats, err := d.ReleaseStatistics(testReleaseID)
+	stats, err := d.ReleaseStatistics(context.Background(), testReleaseID)
 	if err != nil {
 		t.Fatalf("failed to get price suggestion: %s", err)
 	}
@@ -74,3 +104,232 @@ func TestMarketplaceReleaseStatistics(t *testing.T) {
 
 	compareJson(t, string(json), releaseStatsJson)
 }
+
+const testListingID = 41578241
+
+func MarketplaceListingsServer(w http.ResponseWriter, r *http.Request) {
+	var listing ListingRequest
+	if r.Method == "POST" {
+		if err := json.NewDecoder(r.Body).Decode(&listing); err != nil || listing.ReleaseID != testReleaseID || listing.Status != ListingForSale {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+	}
+
+	switch r.URL.Path {
+	case "/marketplace" + listingsURI:
+		if r.Method != "POST" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		if _, err := io.WriteString(w, newListingJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+	case "/marketplace" + listingsURI + "/" + strconv.Itoa(testListingID):
+		if r.Method != "POST" && r.Method != "DELETE" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestMarketplaceListings(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(MarketplaceListingsServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+	ctx := context.Background()
+	listing := &ListingRequest{
+		ReleaseID:       testReleaseID,
+		Condition:       ConditionNearMint,
+		SleeveCondition: ConditionVeryGoodPlus,
+		Price:           19.99,
+		Status:          ListingForSale,
+	}
+
+	created, err := d.CreateListing(ctx, listing)
+	if err != nil {
+		t.Fatalf("failed to create listing: %s", err)
+	}
+	json, err := json.Marshal(created)
+	if err != nil {
+		t.Fatalf("failed to marshal listing: %s", err)
+	}
+	compareJson(t, string(json), newListingJson)
+
+	listing.Price = 17.99
+	if err := d.EditListing(ctx, created.ListingID, listing); err != nil {
+		t.Fatalf("failed to edit listing: %s", err)
+	}
+
+	if err := d.DeleteListing(ctx, created.ListingID); err != nil {
+		t.Fatalf("failed to delete listing: %s", err)
+	}
+
+	if err := d.DeleteListing(ctx, 0); err != ErrInvalidListingID {
+		t.Fatalf("err got=%v; want=%s", err, ErrInvalidListingID)
+	}
+}
+
+func TestMarketplaceCallCurrency(t *testing.T) {
+	var currencies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/marketplace" + feeURI + "10.00/EUR":
+			currencies = append(currencies, "EUR")
+			w.WriteHeader(http.StatusOK)
+			_, _ = io.WriteString(w, feeJson)
+		case "/marketplace" + releaseStatsURI + strconv.Itoa(testReleaseID):
+			currencies = append(currencies, r.URL.Query().Get("curr_abbr"))
+			w.WriteHeader(http.StatusOK)
+			_, _ = io.WriteString(w, releaseStatsJson)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+	ctx := context.Background()
+
+	if _, err := d.Fee(ctx, 10, WithCurrency("EUR")); err != nil {
+		t.Fatalf("failed to get fee: %s", err)
+	}
+	if _, err := d.ReleaseStatistics(ctx, testReleaseID, WithCurrency("GBP")); err != nil {
+		t.Fatalf("failed to get stats: %s", err)
+	}
+	if _, err := d.ReleaseStatistics(ctx, testReleaseID); err != nil {
+		t.Fatalf("failed to get stats: %s", err)
+	}
+	if want := []string{"EUR", "GBP", "USD"}; !reflect.DeepEqual(currencies, want) {
+		t.Errorf("currencies got=%v; want=%v", currencies, want)
+	}
+
+	if _, err := d.Fee(ctx, 10, WithCurrency("RUB")); err != ErrCurrencyNotSupported {
+		t.Errorf("err got=%v; want=%s", err, ErrCurrencyNotSupported)
+	}
+}
+
+func TestMarketplaceWatchReleaseListings(t *testing.T) {
+	stats := []string{
+		`{"lowest_price": {"currency": "USD", "value": 20}, "num_for_sale": 1, "blocked_from_sale": false}`,
+		`{"lowest_price": {"currency": "USD", "value": 15}, "num_for_sale": 2, "blocked_from_sale": false}`,
+		`{"lowest_price": {"currency": "USD", "value": 15}, "num_for_sale": 2, "blocked_from_sale": false}`,
+		`{"lowest_price": null, "num_for_sale": 0, "blocked_from_sale": false}`,
+	}
+	var mu sync.Mutex
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(stats) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, stats[0])
+		stats = stats[1:]
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+	events, err := d.WatchReleaseListings(ctx, testReleaseID, time.Millisecond)
+	if err != nil {
+		t.Fatalf("failed to watch release listings: %s", err)
+	}
+
+	want := []ListingEventType{ListingEventNew, ListingEventPriceDrop, ListingEventSoldOut, ListingEventError}
+	for _, typ := range want {
+		e := <-events
+		if e.Type != typ {
+			t.Fatalf("event got=%s; want=%s", e.Type, typ)
+		}
+		if e.ReleaseID != testReleaseID {
+			t.Errorf("release id got=%d; want=%d", e.ReleaseID, testReleaseID)
+		}
+	}
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestMarketplaceWatchReleaseListingsErrors(t *testing.T) {
+	d := initDiscogsClient(t, nil)
+	if _, err := d.WatchReleaseListings(context.Background(), testReleaseID, 0); err != ErrInvalidInterval {
+		t.Errorf("err got=%v; want=%s", err, ErrInvalidInterval)
+	}
+	if _, err := d.WatchReleaseListings(context.Background(), testReleaseID, time.Second, WithCurrency("XYZ")); err != ErrCurrencyNotSupported {
+		t.Errorf("err got=%v; want=%s", err, ErrCurrencyNotSupported)
+	}
+}
+
+func MarketplaceInventoryServer(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" || r.URL.Path != "/users/"+testUsername+"/inventory" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	if r.URL.Query().Get("page") == "2" {
+		_, _ = io.WriteString(w, `{"pagination": {"per_page": 1, "items": 2, "page": 2, "pages": 2, "urls": {}}, "listings": [{"id": 150899905}]}`)
+		return
+	}
+	if r.URL.Query().Get("sort") != "price" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	_, _ = io.WriteString(w, inventoryJson)
+}
+
+func TestMarketplaceInventory(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(MarketplaceInventoryServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	inventory, err := d.Inventory(context.Background(), testUsername, &Pagination{Sort: SortByPrice, PerPage: 1})
+	if err != nil {
+		t.Fatalf("failed to get inventory: %s", err)
+	}
+	json, err := json.Marshal(inventory)
+	if err != nil {
+		t.Fatalf("failed to marshal inventory: %s", err)
+	}
+	compareJson(t, string(json), inventoryJson)
+
+	var ids []int
+	err = d.InventoryPager(testUsername, &Pagination{Sort: SortByPrice, PerPage: 1}).ForEach(context.Background(), func(l InventoryListing) error {
+		ids = append(ids, l.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to iterate inventory: %s", err)
+	}
+	if !reflect.DeepEqual(ids, []int{150899904, 150899905}) {
+		t.Errorf("ids got=%v; want=[150899904 150899905]", ids)
+	}
+}
+
+func TestMarketplaceInventoryErrors(t *testing.T) {
+	d := initDiscogsClient(t, nil)
+	ctx := context.Background()
+
+	if _, err := d.Inventory(ctx, "", nil); err != ErrInvalidUsername {
+		t.Errorf("err got=%v; want=%s", err, ErrInvalidUsername)
+	}
+	if _, err := d.Inventory(ctx, testUsername, &Pagination{Sort: SortByYear}); err != ErrInvalidSortKey {
+		t.Errorf("err got=%v; want=%s", err, ErrInvalidSortKey)
+	}
+	if _, err := d.InventoryPager(testUsername, &Pagination{SortOrder: "up"}).Next(ctx); err != ErrInvalidSortOrder {
+		t.Errorf("err got=%v; want=%s", err, ErrInvalidSortOrder)
+	}
+}
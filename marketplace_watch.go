@@ -0,0 +1,101 @@
+package discogs
+
+import (
+	"context"
+	"time"
+)
+
+// ListingEventType is a kind of change of a release's marketplace listings.
+type ListingEventType string
+
+// Listing event types.
+const (
+	// ListingEventNew is emitted when more copies of a release are for sale than before.
+	ListingEventNew ListingEventType = "new"
+	// ListingEventPriceDrop is emitted when the lowest price of a release drops.
+	ListingEventPriceDrop ListingEventType = "price_drop"
+	// ListingEventSoldOut is emitted when no copies of a release are for sale anymore.
+	ListingEventSoldOut ListingEventType = "sold_out"
+	// ListingEventError is emitted when querying statistics failed, watching goes on.
+	ListingEventError ListingEventType = "error"
+)
+
+// ListingEvent is a change of a release's marketplace listings found by WatchReleaseListings.
+type ListingEvent struct {
+	Type      ListingEventType
+	ReleaseID int
+	Previous  *Stats // statistics before the change
+	Current   *Stats // statistics after the change, nil for ListingEventError
+	Err       error  // error of the failed query for ListingEventError
+}
+
+func (s *marketPlaceService) WatchReleaseListings(ctx context.Context, releaseID int, interval time.Duration, opts ...CallOption) (<-chan ListingEvent, error) {
+	if interval <= 0 {
+		return nil, ErrInvalidInterval
+	}
+	// the watching goroutine would write raw responses while the caller reads them
+	ctx = withoutRawResponse(ctx)
+	// the first query is made right away, so invalid releases and options fail here
+	previous, err := s.ReleaseStatistics(ctx, releaseID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan ListingEvent)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			current, err := s.ReleaseStatistics(ctx, releaseID, opts...)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if !sendListingEvent(ctx, events, ListingEvent{Type: ListingEventError, ReleaseID: releaseID, Previous: previous, Err: err}) {
+					return
+				}
+				continue
+			}
+			for _, typ := range listingChanges(previous, current) {
+				if !sendListingEvent(ctx, events, ListingEvent{Type: typ, ReleaseID: releaseID, Previous: previous, Current: current}) {
+					return
+				}
+			}
+			previous = current
+		}
+	}()
+	return events, nil
+}
+
+// listingChanges returns types of events between two statistics of a release.
+func listingChanges(previous, current *Stats) []ListingEventType {
+	var changes []ListingEventType
+	if current.ForSale > previous.ForSale {
+		changes = append(changes, ListingEventNew)
+	}
+	if current.ForSale == 0 && previous.ForSale > 0 {
+		changes = append(changes, ListingEventSoldOut)
+	}
+	if p, c := previous.LowestPrice, current.LowestPrice; p != nil && c != nil && p.Currency == c.Currency && c.Value < p.Value {
+		changes = append(changes, ListingEventPriceDrop)
+	}
+	return changes
+}
+
+// sendListingEvent sends e unless ctx is done first.
+func sendListingEvent(ctx context.Context, events chan<- ListingEvent, e ListingEvent) bool {
+	select {
+	case events <- e:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,46 @@
+package discogs
+
+import "context"
+
+// MediaType is a media type of responses, it sets the format of text fields
+// like profiles and notes.
+type MediaType string
+
+// Media types discogs accepts.
+const (
+	// MediaTypeDiscogs returns text fields with discogs markup, e.g. [a=Artist] links.
+	MediaTypeDiscogs MediaType = "application/vnd.discogs.v2.discogs+json"
+	// MediaTypeHTML returns text fields rendered as HTML.
+	MediaTypeHTML MediaType = "application/vnd.discogs.v2.html+json"
+	// MediaTypePlaintext returns text fields as plain text.
+	MediaTypePlaintext MediaType = "application/vnd.discogs.v2.plaintext+json"
+)
+
+func (m MediaType) valid() bool {
+	switch m {
+	case MediaTypeDiscogs, MediaTypeHTML, MediaTypePlaintext:
+		return true
+	}
+	return false
+}
+
+// mediaTypeKey is a context key of a media type to request.
+type mediaTypeKey struct{}
+
+// WithMediaType returns a context which makes calls request responses of media type m,
+// instead of the one set by Options.MediaType.
+func WithMediaType(ctx context.Context, m MediaType) context.Context {
+	return context.WithValue(ctx, mediaTypeKey{}, m)
+}
+
+// mediaType returns a media type set by WithMediaType or def if none is set.
+func mediaType(ctx context.Context, def MediaType) (MediaType, error) {
+	m, ok := ctx.Value(mediaTypeKey{}).(MediaType)
+	if !ok {
+		return def, nil
+	}
+	if !m.valid() {
+		return "", ErrInvalidMediaType
+	}
+	return m, nil
+}
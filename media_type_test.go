@@ -0,0 +1,47 @@
+package discogs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMediaType(t *testing.T) {
+	var accept string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accept = r.Header.Get("Accept")
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, releaseJson)
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	d := initDiscogsClient(t, &Options{URL: ts.URL, MediaType: MediaTypePlaintext})
+
+	if _, err := d.Release(ctx, 8138518); err != nil {
+		t.Fatalf("failed to get release: %s", err)
+	}
+	if accept != string(MediaTypePlaintext) {
+		t.Errorf("accept got=%s; want=%s", accept, MediaTypePlaintext)
+	}
+
+	if _, err := d.Release(WithMediaType(ctx, MediaTypeHTML), 8138518); err != nil {
+		t.Fatalf("failed to get release: %s", err)
+	}
+	if accept != string(MediaTypeHTML) {
+		t.Errorf("accept got=%s; want=%s", accept, MediaTypeHTML)
+	}
+
+	if _, err := d.Release(WithMediaType(ctx, "text/html"), 8138518); err != ErrInvalidMediaType {
+		t.Errorf("err got=%v; want=%s", err, ErrInvalidMediaType)
+	}
+}
+
+func TestMediaTypeInvalid(t *testing.T) {
+	_, err := New(&Options{UserAgent: testUserAgent, MediaType: "text/html"})
+	if err != ErrInvalidMediaType {
+		t.Errorf("err got=%v; want=%s", err, ErrInvalidMediaType)
+	}
+}
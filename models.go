@@ -193,16 +193,82 @@ type Notes struct {
 	Value   string  `json:"value"`
 }
 
+// SortKey is a key items of a list are sorted by.
+// Every endpoint accepts its own subset of keys.
+type SortKey string
+
+// Sort keys.
+const (
+	SortByAdded    SortKey = "added"
+	SortByArtist   SortKey = "artist"
+	SortByAudio    SortKey = "audio"
+	SortByCatno    SortKey = "catno"
+	SortByCountry  SortKey = "country"
+	SortByFormat   SortKey = "format"
+	SortByItem     SortKey = "item"
+	SortByLabel    SortKey = "label"
+	SortByListed   SortKey = "listed"
+	SortByLocation SortKey = "location"
+	SortByPrice    SortKey = "price"
+	SortByRating   SortKey = "rating"
+	SortByReleased SortKey = "released"
+	SortByStatus   SortKey = "status"
+	SortByTitle    SortKey = "title"
+	SortByYear     SortKey = "year"
+)
+
+// SortOrder is a direction items of a list are sorted in.
+type SortOrder string
+
+// Sort orders.
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
 // Pagination ...
 type Pagination struct {
-	// TODO(irlndts): validate requested Sort
-	Sort string // year, title, format etc
-	// TODO(irlndts): validate requested SortOrder
-	SortOrder string // asc, desc
+	Sort      SortKey   // year, title, format etc
+	SortOrder SortOrder // asc, desc
 	Page      int
 	PerPage   int
 }
 
+// sortKeys is a set of sort keys accepted by an endpoint.
+type sortKeys map[SortKey]struct{}
+
+func newSortKeys(keys ...SortKey) sortKeys {
+	set := sortKeys{}
+	for _, k := range keys {
+		set[k] = struct{}{}
+	}
+	return set
+}
+
+// validate checks that requested sorting is accepted by an endpoint.
+func (p *Pagination) validate(keys sortKeys) error {
+	if p == nil {
+		return nil
+	}
+	return keys.validate(p.Sort, p.SortOrder)
+}
+
+// validate checks that key is one of keys and order is a valid sort order.
+func (keys sortKeys) validate(key SortKey, order SortOrder) error {
+	if _, ok := keys[key]; key != "" && !ok {
+		return ErrInvalidSortKey
+	}
+	return order.validate()
+}
+
+func (o SortOrder) validate() error {
+	switch o {
+	case "", SortAsc, SortDesc:
+		return nil
+	}
+	return ErrInvalidSortOrder
+}
+
 // toParams converts pagaination params to request values
 func (p *Pagination) params() url.Values {
 	if p == nil {
@@ -210,8 +276,8 @@ func (p *Pagination) params() url.Values {
 	}
 
 	params := url.Values{}
-	params.Set("sort", p.Sort)
-	params.Set("sort_order", p.SortOrder)
+	params.Set("sort", string(p.Sort))
+	params.Set("sort_order", string(p.SortOrder))
 	params.Set("page", strconv.Itoa(p.Page))
 	params.Set("per_page", strconv.Itoa(p.PerPage))
 	return params
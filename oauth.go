@@ -0,0 +1,234 @@
+package discogs
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	discogsWeb     = "https://www.discogs.com"
+	oauthAuthorize = "/oauth/authorize"
+)
+
+// OAuthService is an interface to work with Discogs OAuth 1.0a flow.
+// https://www.discogs.com/developers/#page:authentication,header:authentication-oauth-flow
+type OAuthService interface {
+	// RequestToken obtains a temporary request token.
+	// callback is the URL the user is redirected to once the token is authorized, or "oob".
+	RequestToken(ctx context.Context, callback string) (*OAuthToken, error)
+	// AuthorizeURL returns the URL the user has to visit to authorize the request token.
+	AuthorizeURL(token *OAuthToken) string
+	// AccessToken exchanges an authorized request token and its verifier for an access token.
+	AccessToken(ctx context.Context, token *OAuthToken, verifier string) (*OAuthToken, error)
+}
+
+// OAuth is a set of OAuth 1.0a credentials.
+// Consumer key and secret identify the application, token and token secret
+// identify the user the application acts on behalf of.
+type OAuth struct {
+	ConsumerKey    string
+	ConsumerSecret string
+	// Token is the user's access token (optional until the user authorized the application).
+	Token string
+	// TokenSecret is the user's access token secret.
+	TokenSecret string
+}
+
+// OAuthToken is a token and secret pair returned by OAuth flow.
+type OAuthToken struct {
+	Token  string
+	Secret string
+}
+
+type oauthService struct {
+	*client
+	url string
+}
+
+func newOAuthService(c *client, url string) OAuthService {
+	return &oauthService{
+		client: c,
+		url:    url,
+	}
+}
+
+func (s *oauthService) RequestToken(ctx context.Context, callback string) (*OAuthToken, error) {
+	return s.token(ctx, "GET", s.url+"/request_token", &oauthParams{
+		extra: map[string]string{"oauth_callback": callback},
+	})
+}
+
+func (s *oauthService) AuthorizeURL(token *OAuthToken) string {
+	return discogsWeb + oauthAuthorize + "?oauth_token=" + url.QueryEscape(token.Token)
+}
+
+func (s *oauthService) AccessToken(ctx context.Context, token *OAuthToken, verifier string) (*OAuthToken, error) {
+	if token == nil {
+		return nil, ErrInvalidOAuthToken
+	}
+	return s.token(ctx, "POST", s.url+"/access_token", &oauthParams{
+		token:  token.Token,
+		secret: token.Secret,
+		extra:  map[string]string{"oauth_verifier": verifier},
+	})
+}
+
+func (s *oauthService) token(ctx context.Context, method string, path string, p *oauthParams) (*OAuthToken, error) {
+	if s.oauth == nil {
+		return nil, ErrOAuthRequired
+	}
+
+	r, err := s.newRequest(context.WithValue(ctx, oauthParamsKey{}, p), method, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := s.do(r)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+
+	token := &OAuthToken{
+		Token:  values.Get("oauth_token"),
+		Secret: values.Get("oauth_token_secret"),
+	}
+	if token.Token == "" {
+		return nil, ErrInvalidOAuthToken
+	}
+	return token, nil
+}
+
+// oauthParamsKey is a context key to override the token used to sign a request
+// and to add protocol parameters, e.g. oauth_verifier.
+type oauthParamsKey struct{}
+
+type oauthParams struct {
+	token  string
+	secret string
+	extra  map[string]string
+}
+
+// these are variables to be replaced in tests.
+var (
+	oauthNow   = time.Now
+	oauthNonce = func() (string, error) {
+		b := make([]byte, 16)
+		if _, err := rand.Read(b); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(b), nil
+	}
+)
+
+// sign sets Authorization header with HMAC-SHA1 signature of the request.
+func (o *OAuth) sign(r *http.Request) error {
+	token, secret := o.Token, o.TokenSecret
+	var extra map[string]string
+	if p, ok := r.Context().Value(oauthParamsKey{}).(*oauthParams); ok {
+		token, secret, extra = p.token, p.secret, p.extra
+	}
+
+	nonce, err := oauthNonce()
+	if err != nil {
+		return err
+	}
+
+	params := map[string]string{
+		"oauth_consumer_key":     o.ConsumerKey,
+		"oauth_nonce":            nonce,
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(oauthNow().Unix(), 10),
+		"oauth_version":          "1.0",
+	}
+	if token != "" {
+		params["oauth_token"] = token
+	}
+	for k, v := range extra {
+		params[k] = v
+	}
+	params["oauth_signature"] = oauthSignature(r.Method, r.URL, params, o.ConsumerSecret, secret)
+
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	header := make([]string, 0, len(keys))
+	for _, k := range keys {
+		header = append(header, oauthEscape(k)+`="`+oauthEscape(params[k])+`"`)
+	}
+	r.Header.Set("Authorization", "OAuth "+strings.Join(header, ", "))
+	return nil
+}
+
+// oauthSignature calculates HMAC-SHA1 signature as described in RFC 5849, section 3.4.
+func oauthSignature(method string, u *url.URL, oauthParams map[string]string, consumerSecret, tokenSecret string) string {
+	var pairs []string
+	for k, vs := range u.Query() {
+		for _, v := range vs {
+			pairs = append(pairs, oauthEscape(k)+"="+oauthEscape(v))
+		}
+	}
+	for k, v := range oauthParams {
+		pairs = append(pairs, oauthEscape(k)+"="+oauthEscape(v))
+	}
+	sort.Strings(pairs)
+
+	base := strings.ToUpper(method) + "&" +
+		oauthEscape(oauthBaseURL(u)) + "&" +
+		oauthEscape(strings.Join(pairs, "&"))
+
+	mac := hmac.New(sha1.New, []byte(oauthEscape(consumerSecret)+"&"+oauthEscape(tokenSecret)))
+	mac.Write([]byte(base))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// oauthBaseURL returns URL without query and default port.
+func oauthBaseURL(u *url.URL) string {
+	scheme := strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Host)
+	if port := u.Port(); (scheme == "http" && port == "80") || (scheme == "https" && port == "443") {
+		host = strings.ToLower(u.Hostname())
+	}
+	return scheme + "://" + host + u.EscapedPath()
+}
+
+// oauthEscape percent-encodes s as described in RFC 5849, section 3.6.
+func oauthEscape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
@@ -0,0 +1,125 @@
+package discogs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestOAuthSignature(t *testing.T) {
+	// https://oauth.net/core/1.0a/#sig_base_example
+	u, err := url.Parse("http://photos.example.net/photos?file=vacation.jpg&size=original")
+	if err != nil {
+		t.Fatalf("failed to parse url: %s", err)
+	}
+	params := map[string]string{
+		"oauth_consumer_key":     "dpf43f3p2l4k3l03",
+		"oauth_token":            "nnch734d00sl2jdk",
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        "1191242096",
+		"oauth_nonce":            "kllo9940pd9333jh",
+		"oauth_version":          "1.0",
+	}
+
+	got := oauthSignature("GET", u, params, "kd94hf93k423kf44", "pfkkdhi9sl3r4s00")
+	if want := "tR3+Ty81lMeYAr/Fid0kMTYa/WM="; got != want {
+		t.Errorf("signature got=%s; want=%s", got, want)
+	}
+}
+
+func TestOAuthEscape(t *testing.T) {
+	tests := map[string]string{
+		"abcABC123-._~": "abcABC123-._~",
+		"a b":           "a%20b",
+		"a+b=c&d":       "a%2Bb%3Dc%26d",
+		"ü":             "%C3%BC",
+	}
+	for in, want := range tests {
+		if got := oauthEscape(in); got != want {
+			t.Errorf("escape %q got=%s; want=%s", in, got, want)
+		}
+	}
+}
+
+func OAuthServer(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "OAuth ") || !strings.Contains(auth, `oauth_consumer_key="key"`) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/oauth/request_token":
+		if r.Method != "GET" || !strings.Contains(auth, `oauth_callback="http%3A%2F%2Flocalhost%2Fcallback"`) {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, "oauth_token=request-token&oauth_token_secret=request-secret&oauth_callback_confirmed=true")
+	case "/oauth/access_token":
+		if r.Method != "POST" || !strings.Contains(auth, `oauth_token="request-token"`) || !strings.Contains(auth, `oauth_verifier="verifier"`) {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, "oauth_token=access-token&oauth_token_secret=access-secret")
+	case "/releases/8138518":
+		if !strings.Contains(auth, `oauth_token="access-token"`) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, releaseJson)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestOAuthFlow(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(OAuthServer))
+	defer ts.Close()
+
+	ctx := context.Background()
+	d := initDiscogsClient(t, &Options{URL: ts.URL, OAuth: &OAuth{ConsumerKey: "key", ConsumerSecret: "secret"}})
+
+	requestToken, err := d.RequestToken(ctx, "http://localhost/callback")
+	if err != nil {
+		t.Fatalf("failed to get request token: %s", err)
+	}
+	if requestToken.Token != "request-token" || requestToken.Secret != "request-secret" {
+		t.Fatalf("request token got=%+v", requestToken)
+	}
+
+	if got, want := d.AuthorizeURL(requestToken), "https://www.discogs.com/oauth/authorize?oauth_token=request-token"; got != want {
+		t.Errorf("authorize url got=%s; want=%s", got, want)
+	}
+
+	accessToken, err := d.AccessToken(ctx, requestToken, "verifier")
+	if err != nil {
+		t.Fatalf("failed to get access token: %s", err)
+	}
+	if accessToken.Token != "access-token" || accessToken.Secret != "access-secret" {
+		t.Fatalf("access token got=%+v", accessToken)
+	}
+
+	d = initDiscogsClient(t, &Options{URL: ts.URL, OAuth: &OAuth{
+		ConsumerKey:    "key",
+		ConsumerSecret: "secret",
+		Token:          accessToken.Token,
+		TokenSecret:    accessToken.Secret,
+	}})
+	if _, err := d.Release(ctx, 8138518); err != nil {
+		t.Fatalf("failed to get release: %s", err)
+	}
+}
+
+func TestOAuthRequired(t *testing.T) {
+	d := initDiscogsClient(t, nil)
+	if _, err := d.RequestToken(context.Background(), "oob"); err != ErrOAuthRequired {
+		t.Fatalf("err got=%v; want=%s", err, ErrOAuthRequired)
+	}
+}
@@ -0,0 +1,288 @@
+package discogs
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// OrdersService is an interface to work with marketplace orders.
+// Authentication as the seller is required.
+type OrdersService interface {
+	// Orders returns a list of the authenticated seller’s orders.
+	Orders(ctx context.Context, req *OrdersRequest) (*Orders, error)
+	// OrdersPager iterates over all pages of the authenticated seller’s orders.
+	OrdersPager(req *OrdersRequest) *Pager[Order]
+	// Order returns an order by its ID, e.g. "1-1".
+	Order(ctx context.Context, orderID string) (*Order, error)
+	// EditOrder changes status or shipping of an order and returns the result.
+	EditOrder(ctx context.Context, orderID string, update *OrderUpdate) (*Order, error)
+	// OrderMessages returns messages of an order, the most recent first.
+	OrderMessages(ctx context.Context, orderID string, pagination *Pagination) (*OrderMessages, error)
+	// OrderMessagesPager iterates over all pages of messages of an order.
+	OrderMessagesPager(orderID string, pagination *Pagination) *Pager[OrderMessage]
+	// AddOrderMessage adds a message to an order and/or changes its status.
+	AddOrderMessage(ctx context.Context, orderID string, message *OrderMessageRequest) (*OrderMessage, error)
+}
+
+type ordersService struct {
+	*client
+	url string
+}
+
+func newOrdersService(c *client, url string) OrdersService {
+	return &ordersService{
+		client: c,
+		url:    url,
+	}
+}
+
+// OrderStatus is a status of a marketplace order.
+type OrderStatus string
+
+// Order statuses.
+const (
+	OrderNewOrder                  OrderStatus = "New Order"
+	OrderBuyerContacted            OrderStatus = "Buyer Contacted"
+	OrderInvoiceSent               OrderStatus = "Invoice Sent"
+	OrderPaymentPending            OrderStatus = "Payment Pending"
+	OrderPaymentReceived           OrderStatus = "Payment Received"
+	OrderInProgress                OrderStatus = "In Progress"
+	OrderShipped                   OrderStatus = "Shipped"
+	OrderMerged                    OrderStatus = "Merged"
+	OrderOrderChanged              OrderStatus = "Order Changed"
+	OrderRefundSent                OrderStatus = "Refund Sent"
+	OrderCancelled                 OrderStatus = "Cancelled"
+	OrderCancelledNonPayingBuyer   OrderStatus = "Cancelled (Non-Paying Buyer)"
+	OrderCancelledItemUnavailable  OrderStatus = "Cancelled (Item Unavailable)"
+	OrderCancelledPerBuyersRequest OrderStatus = "Cancelled (Per Buyer's Request)"
+	// OrderAll is a filter matching orders of any status.
+	OrderAll OrderStatus = "All"
+)
+
+// OrderSort is a sort key of orders list.
+type OrderSort string
+
+// Order sort keys.
+const (
+	OrderSortID           OrderSort = "id"
+	OrderSortBuyer        OrderSort = "buyer"
+	OrderSortCreated      OrderSort = "created"
+	OrderSortStatus       OrderSort = "status"
+	OrderSortLastActivity OrderSort = "last_activity"
+)
+
+// Order serves marketplace order response from discogs.
+type Order struct {
+	ID                     string        `json:"id"`
+	Status                 OrderStatus   `json:"status"`
+	NextStatus             []OrderStatus `json:"next_status"`
+	Created                string        `json:"created"`
+	LastActivity           string        `json:"last_activity"`
+	Archived               bool          `json:"archived"`
+	Items                  []OrderItem   `json:"items"`
+	Fee                    *Listing      `json:"fee"`
+	Total                  *Listing      `json:"total"`
+	Shipping               Shipping      `json:"shipping"`
+	ShippingAddress        string        `json:"shipping_address"`
+	AdditionalInstructions string        `json:"additional_instructions"`
+	Buyer                  OrderUser     `json:"buyer"`
+	Seller                 OrderUser     `json:"seller"`
+	MessagesURL            string        `json:"messages_url"`
+	ResourceURL            string        `json:"resource_url"`
+	URI                    string        `json:"uri"`
+}
+
+// OrderItem is a listing in an order.
+type OrderItem struct {
+	ID              int          `json:"id"`
+	Release         OrderRelease `json:"release"`
+	Price           *Listing     `json:"price"`
+	MediaCondition  Condition    `json:"media_condition"`
+	SleeveCondition Condition    `json:"sleeve_condition"`
+}
+
+// OrderRelease is a release of an ordered item.
+type OrderRelease struct {
+	ID          int    `json:"id"`
+	Description string `json:"description"`
+}
+
+// Shipping is a shipping method and its cost.
+type Shipping struct {
+	Method   string  `json:"method"`
+	Currency string  `json:"currency"`
+	Value    float64 `json:"value"`
+}
+
+// OrderUser is a buyer or a seller of an order.
+type OrderUser struct {
+	ID          int    `json:"id"`
+	Username    string `json:"username"`
+	ResourceURL string `json:"resource_url"`
+}
+
+// Orders is a list of orders.
+type Orders struct {
+	Pagination Page    `json:"pagination"`
+	Orders     []Order `json:"orders"`
+}
+
+// OrdersRequest describes orders request.
+type OrdersRequest struct {
+	Status        OrderStatus // only orders with the status (optional)
+	CreatedAfter  string      // only orders created after the ISO 8601 timestamp (optional)
+	CreatedBefore string      // only orders created before the ISO 8601 timestamp (optional)
+	Archived      *bool       // only archived or not archived orders (optional)
+	Sort          OrderSort   // sort key (optional)
+	SortOrder     SortOrder   // asc, desc (optional)
+
+	Page    int
+	PerPage int
+}
+
+func (r *OrdersRequest) params() url.Values {
+	if r == nil {
+		return nil
+	}
+
+	params := url.Values{}
+	if r.Status != "" {
+		params.Set("status", string(r.Status))
+	}
+	if r.CreatedAfter != "" {
+		params.Set("created_after", r.CreatedAfter)
+	}
+	if r.CreatedBefore != "" {
+		params.Set("created_before", r.CreatedBefore)
+	}
+	if r.Archived != nil {
+		params.Set("archived", strconv.FormatBool(*r.Archived))
+	}
+	if r.Sort != "" {
+		params.Set("sort", string(r.Sort))
+	}
+	if r.SortOrder != "" {
+		params.Set("sort_order", string(r.SortOrder))
+	}
+	if r.Page != 0 {
+		params.Set("page", strconv.Itoa(r.Page))
+	}
+	if r.PerPage != 0 {
+		params.Set("per_page", strconv.Itoa(r.PerPage))
+	}
+	return params
+}
+
+var validOrdersSort = newSortKeys(
+	SortKey(OrderSortID),
+	SortKey(OrderSortBuyer),
+	SortKey(OrderSortCreated),
+	SortKey(OrderSortStatus),
+	SortKey(OrderSortLastActivity),
+)
+
+func (r *OrdersRequest) validate() error {
+	if r == nil {
+		return nil
+	}
+	return validOrdersSort.validate(SortKey(r.Sort), r.SortOrder)
+}
+
+func (s *ordersService) Orders(ctx context.Context, req *OrdersRequest) (*Orders, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	var orders *Orders
+	err := s.request(ctx, s.url, req.params(), &orders)
+	return orders, err
+}
+
+func (s *ordersService) OrdersPager(req *OrdersRequest) *Pager[Order] {
+	if err := req.validate(); err != nil {
+		return errPager[Order](err)
+	}
+	return newPager[Order](s.client, s.url, req.params(), "orders")
+}
+
+func (s *ordersService) Order(ctx context.Context, orderID string) (*Order, error) {
+	if orderID == "" {
+		return nil, ErrInvalidOrderID
+	}
+	var order *Order
+	err := s.request(ctx, s.url+"/"+orderID, nil, &order)
+	return order, err
+}
+
+// OrderUpdate describes changes of an order.
+type OrderUpdate struct {
+	Status   OrderStatus `json:"status,omitempty"`   // new status, one of the order’s NextStatus (optional)
+	Shipping *float64    `json:"shipping,omitempty"` // shipping cost in the order’s currency (optional)
+}
+
+func (s *ordersService) EditOrder(ctx context.Context, orderID string, update *OrderUpdate) (*Order, error) {
+	if orderID == "" {
+		return nil, ErrInvalidOrderID
+	}
+	var order *Order
+	err := s.requestWithJSONBody(ctx, "POST", s.url+"/"+orderID, nil, update, &order)
+	return order, err
+}
+
+// OrderMessage is a message or an event of an order.
+type OrderMessage struct {
+	Type      string         `json:"type"` // one of message, status, shipping, refund_sent, refund_received
+	Subject   string         `json:"subject"`
+	Message   string         `json:"message"`
+	Timestamp string         `json:"timestamp"`
+	From      *OrderUser     `json:"from,omitempty"`
+	Order     OrderReference `json:"order"`
+	StatusID  int            `json:"status_id,omitempty"`
+}
+
+// OrderReference is a reference to an order.
+type OrderReference struct {
+	ID          string `json:"id"`
+	ResourceURL string `json:"resource_url"`
+}
+
+// OrderMessages is a list of order messages.
+type OrderMessages struct {
+	Pagination Page           `json:"pagination"`
+	Messages   []OrderMessage `json:"messages"`
+}
+
+func (s *ordersService) OrderMessages(ctx context.Context, orderID string, pagination *Pagination) (*OrderMessages, error) {
+	if orderID == "" {
+		return nil, ErrInvalidOrderID
+	}
+	var messages *OrderMessages
+	err := s.request(ctx, s.url+"/"+orderID+"/messages", pagination.params(), &messages)
+	return messages, err
+}
+
+func (s *ordersService) OrderMessagesPager(orderID string, pagination *Pagination) *Pager[OrderMessage] {
+	if orderID == "" {
+		return errPager[OrderMessage](ErrInvalidOrderID)
+	}
+	return newPager[OrderMessage](s.client, s.url+"/"+orderID+"/messages", pagination.params(), "messages")
+}
+
+// OrderMessageRequest describes a message added to an order.
+// At least one of Message and Status is required.
+type OrderMessageRequest struct {
+	Message string      `json:"message,omitempty"` // message to the buyer (optional)
+	Status  OrderStatus `json:"status,omitempty"`  // new status of the order (optional)
+}
+
+func (s *ordersService) AddOrderMessage(ctx context.Context, orderID string, message *OrderMessageRequest) (*OrderMessage, error) {
+	if orderID == "" {
+		return nil, ErrInvalidOrderID
+	}
+	if message == nil || (message.Message == "" && message.Status == "") {
+		return nil, ErrInvalidOrderMessage
+	}
+	var m *OrderMessage
+	err := s.requestWithJSONBody(ctx, "POST", s.url+"/"+orderID+"/messages", nil, message, &m)
+	return m, err
+}
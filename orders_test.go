@@ -0,0 +1,185 @@
+package discogs
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testOrderID = "1-1"
+
+func OrdersServer(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/marketplace/orders":
+		if r.Method != "GET" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		q := r.URL.Query()
+		if q.Get("status") != string(OrderInvoiceSent) || q.Get("archived") != "false" || q.Get("sort") != "last_activity" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, ordersJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+	case "/marketplace/orders/" + testOrderID:
+		switch r.Method {
+		case "GET":
+		case "POST":
+			var update OrderUpdate
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil || update.Status != OrderShipped || update.Shipping == nil {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				return
+			}
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, orderJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+	case "/marketplace/orders/" + testOrderID + "/messages":
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			if _, err := io.WriteString(w, orderMessagesJson); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		case "POST":
+			var message OrderMessageRequest
+			if err := json.NewDecoder(r.Body).Decode(&message); err != nil || message.Message != "Thank you for your order!" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			if _, err := io.WriteString(w, orderMessageJson); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestOrdersServiceOrders(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(OrdersServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	archived := false
+	orders, err := d.Orders(context.Background(), &OrdersRequest{Status: OrderInvoiceSent, Archived: &archived, Sort: OrderSortLastActivity})
+	if err != nil {
+		t.Fatalf("failed to get orders: %s", err)
+	}
+
+	json, err := json.Marshal(orders)
+	if err != nil {
+		t.Fatalf("failed to marshal orders: %s", err)
+	}
+
+	compareJson(t, string(json), ordersJson)
+}
+
+func TestOrdersServiceOrdersSortErrors(t *testing.T) {
+	d := initDiscogsClient(t, nil)
+	ctx := context.Background()
+
+	if _, err := d.Orders(ctx, &OrdersRequest{Sort: "price"}); err != ErrInvalidSortKey {
+		t.Errorf("orders err got=%v; want=%s", err, ErrInvalidSortKey)
+	}
+	if _, err := d.OrdersPager(&OrdersRequest{Sort: OrderSortCreated, SortOrder: "up"}).Next(ctx); err != ErrInvalidSortOrder {
+		t.Errorf("orders pager err got=%v; want=%s", err, ErrInvalidSortOrder)
+	}
+}
+
+func TestOrdersServiceOrder(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(OrdersServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	order, err := d.Order(context.Background(), testOrderID)
+	if err != nil {
+		t.Fatalf("failed to get order: %s", err)
+	}
+
+	json, err := json.Marshal(order)
+	if err != nil {
+		t.Fatalf("failed to marshal order: %s", err)
+	}
+
+	compareJson(t, string(json), orderJson)
+}
+
+func TestOrdersServiceEditOrder(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(OrdersServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	shipping := 5.0
+	if _, err := d.EditOrder(context.Background(), testOrderID, &OrderUpdate{Status: OrderShipped, Shipping: &shipping}); err != nil {
+		t.Fatalf("failed to edit order: %s", err)
+	}
+
+	if _, err := d.EditOrder(context.Background(), "", nil); err != ErrInvalidOrderID {
+		t.Fatalf("err got=%v; want=%s", err, ErrInvalidOrderID)
+	}
+}
+
+func TestOrdersServiceOrderMessages(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(OrdersServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	messages, err := d.OrderMessages(context.Background(), testOrderID, nil)
+	if err != nil {
+		t.Fatalf("failed to get order messages: %s", err)
+	}
+
+	json, err := json.Marshal(messages)
+	if err != nil {
+		t.Fatalf("failed to marshal order messages: %s", err)
+	}
+
+	compareJson(t, string(json), orderMessagesJson)
+}
+
+func TestOrdersServiceAddOrderMessage(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(OrdersServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	message, err := d.AddOrderMessage(context.Background(), testOrderID, &OrderMessageRequest{Message: "Thank you for your order!"})
+	if err != nil {
+		t.Fatalf("failed to add order message: %s", err)
+	}
+
+	json, err := json.Marshal(message)
+	if err != nil {
+		t.Fatalf("failed to marshal order message: %s", err)
+	}
+	compareJson(t, string(json), orderMessageJson)
+
+	if _, err := d.AddOrderMessage(context.Background(), testOrderID, &OrderMessageRequest{}); err != ErrInvalidOrderMessage {
+		t.Fatalf("err got=%v; want=%s", err, ErrInvalidOrderMessage)
+	}
+}
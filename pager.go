@@ -0,0 +1,138 @@
+package discogs
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// maxPerPage is the maximum number of items per page discogs allows.
+const maxPerPage = 100
+
+// Pager iterates over pages of a paginated endpoint.
+// It follows pagination.urls.next of every page until the last one.
+//
+//	pager := client.ArtistReleasesPager(38661, &discogs.Pagination{PerPage: 100})
+//	for pager.More() {
+//		releases, err := pager.Next(ctx)
+//		...
+//	}
+type Pager[T any] struct {
+	c      *client
+	path   string
+	params url.Values
+	// key is a JSON key of page items, e.g. "releases".
+	key string
+	// object is set if the key holds a single object per page instead of an array.
+	object bool
+	page   Page
+	done   bool
+	err    error
+}
+
+func newPager[T any](c *client, path string, params url.Values, key string) *Pager[T] {
+	return &Pager[T]{
+		c:      c,
+		path:   path,
+		params: params,
+		key:    key,
+	}
+}
+
+// newObjectPager returns a pager of pages holding a single object under key,
+// e.g. submissions grouped by type.
+func newObjectPager[T any](c *client, path string, params url.Values, key string) *Pager[T] {
+	p := newPager[T](c, path, params, key)
+	p.object = true
+	return p
+}
+
+// errPager returns a pager which fails with err on the first page.
+func errPager[T any](err error) *Pager[T] {
+	return &Pager[T]{err: err}
+}
+
+// More reports whether there are more pages to fetch.
+func (p *Pager[T]) More() bool {
+	return !p.done
+}
+
+// Page returns pagination of the last fetched page.
+func (p *Pager[T]) Page() Page {
+	return p.page
+}
+
+// Next fetches items of the next page.
+// It returns ErrNoMorePages once the last page has been fetched.
+func (p *Pager[T]) Next(ctx context.Context) ([]T, error) {
+	if p.err != nil {
+		p.done = true
+		return nil, p.err
+	}
+	if p.done {
+		return nil, ErrNoMorePages
+	}
+
+	var resp map[string]json.RawMessage
+	if err := p.c.request(ctx, p.path, p.params, &resp); err != nil {
+		return nil, err
+	}
+
+	var page Page
+	if raw, ok := resp["pagination"]; ok {
+		if err := json.Unmarshal(raw, &page); err != nil {
+			return nil, err
+		}
+	}
+
+	var items []T
+	if raw, ok := resp[p.key]; ok && p.object {
+		var item T
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, err
+		}
+		items = []T{item}
+	} else if ok {
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, err
+		}
+	}
+
+	p.page = page
+	if err := p.advance(page.URLs.Next); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// ForEach calls fn for every item of all remaining pages.
+// It stops at the first error returned by fn.
+func (p *Pager[T]) ForEach(ctx context.Context, fn func(T) error) error {
+	for p.More() {
+		items, err := p.Next(ctx)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// advance takes query of the next page URL. Only the query is used,
+// so pages are requested from the configured API URL.
+func (p *Pager[T]) advance(next string) error {
+	if next == "" {
+		p.done = true
+		return nil
+	}
+	u, err := url.Parse(next)
+	if err != nil {
+		return err
+	}
+	p.params = u.Query()
+	return nil
+}
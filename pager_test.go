@@ -0,0 +1,92 @@
+package discogs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// PagerServer serves three pages of artist releases, one release per page.
+// Next page URLs point to discogs API to make sure only their query is used.
+func PagerServer(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/artists/1/releases" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page == 0 {
+		page = 1
+	}
+
+	next := ""
+	if page < 3 {
+		next = fmt.Sprintf(`"next": "https://api.discogs.com/artists/1/releases?page=%d&per_page=1"`, page+1)
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, `{"pagination": {"per_page": 1, "items": 3, "page": %d, "pages": 3, "urls": {%s}}, "releases": [{"id": %d}]}`, page, next, page)
+}
+
+func TestPager(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(PagerServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+	ctx := context.Background()
+
+	pager := d.ArtistReleasesPager(1, &Pagination{Page: 1, PerPage: 1})
+	var ids []int
+	for pager.More() {
+		releases, err := pager.Next(ctx)
+		if err != nil {
+			t.Fatalf("failed to get page: %s", err)
+		}
+		for _, r := range releases {
+			ids = append(ids, r.ID)
+		}
+	}
+
+	if fmt.Sprint(ids) != "[1 2 3]" {
+		t.Errorf("ids got=%v; want=[1 2 3]", ids)
+	}
+	if got := pager.Page().Page; got != 3 {
+		t.Errorf("last page got=%d; want=3", got)
+	}
+	if _, err := pager.Next(ctx); err != ErrNoMorePages {
+		t.Errorf("err got=%v; want=%s", err, ErrNoMorePages)
+	}
+}
+
+func TestPagerForEach(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(PagerServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	var count int
+	err := d.ArtistReleasesPager(1, nil).ForEach(context.Background(), func(r ReleaseSource) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to iterate: %s", err)
+	}
+	if count != 3 {
+		t.Errorf("releases got=%d; want=3", count)
+	}
+}
+
+func TestPagerError(t *testing.T) {
+	d := initDiscogsClient(t, nil)
+
+	pager := d.CollectionItemsByFolderPager("", 0, nil)
+	if _, err := pager.Next(context.Background()); err != ErrInvalidUsername {
+		t.Fatalf("err got=%v; want=%s", err, ErrInvalidUsername)
+	}
+	if pager.More() {
+		t.Error("pager has more pages after an error")
+	}
+}
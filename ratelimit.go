@@ -0,0 +1,131 @@
+package discogs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	rateLimitTotalHeader     = "X-Discogs-Ratelimit"
+	rateLimitUsedHeader      = "X-Discogs-Ratelimit-Used"
+	rateLimitRemainingHeader = "X-Discogs-Ratelimit-Remaining"
+)
+
+// RateLimit is a state of discogs rate limit.
+// https://www.discogs.com/developers/#page:home,header:home-rate-limiting
+type RateLimit struct {
+	// Total is the number of requests allowed in a one minute window.
+	Total int
+	// Used is the number of requests made in the current window.
+	Used int
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+}
+
+// rateLimitWindow is the moving window discogs counts requests in.
+// It's a variable to be replaced in tests.
+var rateLimitWindow = time.Minute
+
+// rateLimiter keeps track of X-Discogs-Ratelimit headers and
+// delays requests once the rate limit is exhausted.
+type rateLimiter struct {
+	mu        sync.Mutex
+	total     int
+	used      int
+	remaining int
+	updated   time.Time
+	// next is the earliest time the next request may be sent at.
+	next time.Time
+}
+
+// wait blocks until a request may be sent without exceeding the rate limit.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	at := now
+	if l.next.After(at) {
+		at = l.next
+	}
+	if l.total > 0 && l.remaining <= 0 {
+		// discogs uses a moving window, so one request is freed up
+		// roughly every window/total once the limit is reached.
+		interval := rateLimitWindow / time.Duration(l.total)
+		if t := l.updated.Add(interval); t.After(at) {
+			at = t
+		}
+		l.next = at.Add(interval)
+	} else if l.remaining > 0 {
+		l.remaining--
+	}
+	l.mu.Unlock()
+
+	return sleep(ctx, at.Sub(now))
+}
+
+// update stores rate limit state from response headers.
+func (l *rateLimiter) update(h http.Header) {
+	total, err := strconv.Atoi(h.Get(rateLimitTotalHeader))
+	if err != nil {
+		return
+	}
+	used, _ := strconv.Atoi(h.Get(rateLimitUsedHeader))
+	remaining, _ := strconv.Atoi(h.Get(rateLimitRemainingHeader))
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.total = total
+	l.used = used
+	l.remaining = remaining
+	l.updated = time.Now()
+}
+
+// state returns the last known rate limit.
+func (l *rateLimiter) state() RateLimit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return RateLimit{Total: l.total, Used: l.used, Remaining: l.remaining}
+}
+
+// block postpones all following requests for d.
+func (l *rateLimiter) block(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if t := time.Now().Add(d); t.After(l.next) {
+		l.next = t
+	}
+	l.remaining = 0
+}
+
+// retryAfter returns the delay requested by Retry-After header
+// or the rate limit window if there is none.
+func retryAfter(h http.Header) time.Duration {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return rateLimitWindow
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return rateLimitWindow
+}
+
+// sleep pauses for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
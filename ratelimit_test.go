@@ -0,0 +1,150 @@
+package discogs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestThrottleWaitsForRateLimit(t *testing.T) {
+	rateLimitWindow = 200 * time.Millisecond
+	defer func() { rateLimitWindow = time.Minute }()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(rateLimitTotalHeader, "2")
+		w.Header().Set(rateLimitUsedHeader, "2")
+		w.Header().Set(rateLimitRemainingHeader, "0")
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, folderJson)
+	}))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL, Throttle: true})
+	ctx := context.Background()
+
+	if _, err := d.Folder(ctx, testUsername, 0); err != nil {
+		t.Fatalf("failed to get folder: %s", err)
+	}
+
+	start := time.Now()
+	if _, err := d.Folder(ctx, testUsername, 0); err != nil {
+		t.Fatalf("failed to get folder: %s", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("request was not throttled, elapsed=%s", elapsed)
+	}
+}
+
+func TestThrottleRetriesTooManyRequests(t *testing.T) {
+	tests := map[string]struct {
+		throttle bool
+		requests int32
+		err      error
+	}{
+		"throttle":    {throttle: true, requests: 2},
+		"no throttle": {throttle: false, requests: 1, err: ErrTooManyRequests},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			var requests int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&requests, 1) == 1 {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = io.WriteString(w, folderJson)
+			}))
+			defer ts.Close()
+
+			d := initDiscogsClient(t, &Options{URL: ts.URL, Throttle: tt.throttle})
+			if _, err := d.Folder(context.Background(), testUsername, 0); !errors.Is(err, tt.err) {
+				t.Fatalf("err got=%v; want=%v", err, tt.err)
+			}
+			if got := atomic.LoadInt32(&requests); got != tt.requests {
+				t.Errorf("requests got=%d; want=%d", got, tt.requests)
+			}
+		})
+	}
+}
+
+func TestThrottleWaitsUntilContextIsDone(t *testing.T) {
+	const limited = 5
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/users/"+testUsername+"/collection/folders/0" && atomic.AddInt32(&requests, 1) > limited {
+			w.WriteHeader(http.StatusOK)
+			_, _ = io.WriteString(w, folderJson)
+			return
+		}
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL, Throttle: true})
+	if _, err := d.Folder(context.Background(), testUsername, 0); err != nil {
+		t.Fatalf("failed to get folder: %s", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != limited+1 {
+		t.Errorf("requests got=%d; want=%d", got, limited+1)
+	}
+
+	// a folder which stays limited fails only once the context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := d.Folder(ctx, testUsername, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err got=%v; want=%s", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := map[string]struct {
+		value string
+		want  time.Duration
+	}{
+		"empty":   {value: "", want: rateLimitWindow},
+		"seconds": {value: "5", want: 5 * time.Second},
+		"invalid": {value: "soon", want: rateLimitWindow},
+	}
+	for name, tt := range tests {
+		h := http.Header{}
+		h.Set("Retry-After", tt.value)
+		if got := retryAfter(h); got != tt.want {
+			t.Errorf("%s: got=%s; want=%s", name, got, tt.want)
+		}
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(rateLimitTotalHeader, "60")
+		w.Header().Set(rateLimitUsedHeader, "2")
+		w.Header().Set(rateLimitRemainingHeader, "58")
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, folderJson)
+	}))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+	if got := d.RateLimit(); got != (RateLimit{}) {
+		t.Errorf("rate limit before requests got=%+v; want zero", got)
+	}
+
+	if _, err := d.Folder(context.Background(), testUsername, 0); err != nil {
+		t.Fatalf("failed to get folder: %s", err)
+	}
+
+	want := RateLimit{Total: 60, Used: 2, Remaining: 58}
+	if got := d.RateLimit(); got != want {
+		t.Errorf("rate limit got=%+v; want=%+v", got, want)
+	}
+}
@@ -0,0 +1,43 @@
+package discogs
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// rawResponseKey is a context key of where to store a raw response body.
+type rawResponseKey struct{}
+
+// WithRawResponse returns a context which makes a call store the raw JSON
+// body of its response in raw, next to the typed result.
+// Use it to read fields discogs returns which models don't have yet:
+//
+//	var raw json.RawMessage
+//	release, err := client.Release(discogs.WithRawResponse(ctx, &raw), 8138518)
+//
+// Calls sending several requests, like pagers, store the body of the last one.
+// Calls sending requests concurrently, BatchReleases and WatchReleaseListings,
+// don't store bodies, so raw isn't written to while others read it.
+func WithRawResponse(ctx context.Context, raw *json.RawMessage) context.Context {
+	return context.WithValue(ctx, rawResponseKey{}, raw)
+}
+
+// withoutRawResponse returns a context of calls which don't store raw responses.
+func withoutRawResponse(ctx context.Context) context.Context {
+	if !wantsRawResponse(ctx) {
+		return ctx
+	}
+	return context.WithValue(ctx, rawResponseKey{}, (*json.RawMessage)(nil))
+}
+
+func wantsRawResponse(ctx context.Context) bool {
+	raw, ok := ctx.Value(rawResponseKey{}).(*json.RawMessage)
+	return ok && raw != nil
+}
+
+// setRawResponse stores body if ctx was created by WithRawResponse.
+func setRawResponse(ctx context.Context, body []byte) {
+	if raw, ok := ctx.Value(rawResponseKey{}).(*json.RawMessage); ok && raw != nil {
+		*raw = json.RawMessage(body)
+	}
+}
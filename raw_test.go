@@ -0,0 +1,90 @@
+package discogs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithRawResponse(t *testing.T) {
+	const body = `{"id": 0, "name": "All", "count": 95, "resource_url": "", "new_field": "new"}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, body)
+	}))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	var raw json.RawMessage
+	folder, err := d.Folder(WithRawResponse(context.Background(), &raw), testUsername, 0)
+	if err != nil {
+		t.Fatalf("failed to get folder: %s", err)
+	}
+	if folder.Name != "All" {
+		t.Errorf("folder name got=%s; want=All", folder.Name)
+	}
+	if string(raw) != body {
+		t.Errorf("raw got=%s; want=%s", raw, body)
+	}
+
+	var fields struct {
+		NewField string `json:"new_field"`
+	}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		t.Fatalf("failed to unmarshal raw response: %s", err)
+	}
+	if fields.NewField != "new" {
+		t.Errorf("new_field got=%s; want=new", fields.NewField)
+	}
+}
+
+// TestWithRawResponseConcurrentCalls is meant to be run with -race.
+func TestWithRawResponseConcurrentCalls(t *testing.T) {
+	var polls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Path == "/marketplace"+releaseStatsURI+strconv.Itoa(testReleaseID) {
+			// alternate between listed and sold out so every poll sends an event
+			n := atomic.AddInt32(&polls, 1) % 2
+			fmt.Fprintf(w, `{"lowest_price": null, "num_for_sale": %d, "blocked_from_sale": false}`, n)
+			return
+		}
+		_, _ = io.WriteString(w, releaseJson)
+	}))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	var raw json.RawMessage
+	ctx, cancel := context.WithCancel(WithRawResponse(context.Background(), &raw))
+	defer cancel()
+
+	if _, err := d.BatchReleases(ctx, []int{8138518, 8138518, 8138518, 8138518}, 4); err != nil {
+		t.Fatalf("failed to batch releases: %s", err)
+	}
+	if raw != nil {
+		t.Errorf("raw got=%s; want nil for batches", raw)
+	}
+
+	events, err := d.WatchReleaseListings(ctx, testReleaseID, time.Millisecond)
+	if err != nil {
+		t.Fatalf("failed to watch release listings: %s", err)
+	}
+	for i := 0; i < 3; i++ {
+		<-events
+		if raw != nil {
+			t.Errorf("raw got=%s; want nil for watches", raw)
+		}
+	}
+	cancel()
+	for range events {
+	}
+}
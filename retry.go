@@ -0,0 +1,80 @@
+package discogs
+
+import (
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultMinBackoff = time.Second
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy describes how requests failed with 429 Too Many Requests
+// or 5xx server errors are retried. Server errors are retried only for
+// idempotent methods, so a POST is never sent twice.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts including the first one.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry (optional, default is 1s).
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum delay between retries (optional, default is 30s).
+	MaxBackoff time.Duration
+}
+
+// backoff returns exponential delay with jitter before the next attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	min, max := p.MinBackoff, p.MaxBackoff
+	if min <= 0 {
+		min = defaultMinBackoff
+	}
+	if max <= 0 {
+		max = defaultMaxBackoff
+	}
+
+	d := min
+	for i := 1; i < attempt && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+
+	// random delay in [d/2, d]
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+// retryDelay reports whether the request should be sent again and how long to wait before.
+func (c *client) retryDelay(r *http.Request, response *http.Response, attempt int) (time.Duration, bool) {
+	retry := c.retry != nil && attempt < c.retry.MaxAttempts
+
+	switch {
+	case response.StatusCode == http.StatusTooManyRequests:
+		if retry {
+			if response.Header.Get("Retry-After") != "" {
+				return retryAfter(response.Header), true
+			}
+			return c.retry.backoff(attempt), true
+		}
+		if c.throttle {
+			// throttled requests wait until the limit frees up or ctx is done
+			return retryAfter(response.Header), true
+		}
+	case response.StatusCode >= http.StatusInternalServerError && retry && idempotent(r.Method):
+		if response.Header.Get("Retry-After") != "" {
+			return retryAfter(response.Header), true
+		}
+		return c.retry.backoff(attempt), true
+	}
+	return 0, false
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "PUT", "DELETE", "OPTIONS":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package discogs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+
+	tests := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{attempt: 1, min: 50 * time.Millisecond, max: 100 * time.Millisecond},
+		{attempt: 2, min: 100 * time.Millisecond, max: 200 * time.Millisecond},
+		{attempt: 3, min: 150 * time.Millisecond, max: 300 * time.Millisecond},
+		{attempt: 10, min: 150 * time.Millisecond, max: 300 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		if d := p.backoff(tt.attempt); d < tt.min || d > tt.max {
+			t.Errorf("attempt %d: backoff=%s; want in [%s, %s]", tt.attempt, d, tt.min, tt.max)
+		}
+	}
+}
+
+func TestRetry(t *testing.T) {
+	tests := map[string]struct {
+		method   string
+		status   int
+		retry    *RetryPolicy
+		requests int32
+		fail     bool
+	}{
+		"502 retried":             {method: "GET", status: http.StatusBadGateway, retry: &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}, requests: 3},
+		"429 retried":             {method: "GET", status: http.StatusTooManyRequests, retry: &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}, requests: 3},
+		"attempts exhausted":      {method: "GET", status: http.StatusServiceUnavailable, retry: &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond}, requests: 2, fail: true},
+		"no retry policy":         {method: "GET", status: http.StatusServiceUnavailable, requests: 1, fail: true},
+		"post is not retried":     {method: "POST", status: http.StatusServiceUnavailable, retry: &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}, requests: 1, fail: true},
+		"post retried on 429":     {method: "POST", status: http.StatusTooManyRequests, retry: &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}, requests: 3},
+		"client errors are final": {method: "GET", status: http.StatusNotFound, retry: &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}, requests: 1, fail: true},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			var requests int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&requests, 1) < 3 {
+					w.WriteHeader(tt.status)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = io.WriteString(w, `{}`)
+			}))
+			defer ts.Close()
+
+			d := initDiscogsClient(t, &Options{URL: ts.URL, Retry: tt.retry})
+			var err error
+			if tt.method == "POST" {
+				err = d.EditFieldsInstance(context.Background(), testUsername, 1, 1, 1, NotesField, "value")
+			} else {
+				_, err = d.Folder(context.Background(), testUsername, 0)
+			}
+			if (err != nil) != tt.fail {
+				t.Fatalf("err got=%v; want fail=%t", err, tt.fail)
+			}
+			if got := atomic.LoadInt32(&requests); got != tt.requests {
+				t.Errorf("requests got=%d; want=%d", got, tt.requests)
+			}
+		})
+	}
+}
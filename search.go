@@ -1,6 +1,8 @@
 package discogs
 
 import (
+	"context"
+	"encoding/json"
 	"net/url"
 	"strconv"
 )
@@ -11,40 +13,58 @@ type SearchService interface {
 	// Issue a search query to database. This endpoint accepts pagination parameters.
 	// Authentication (as any user) is required.
 	// https://www.discogs.com/developers/#page:database,header:database-search
-	Search(req SearchRequest) (*Search, error)
+	Search(ctx context.Context, req SearchRequest) (*Search, error)
+	// SearchPager iterates over all pages of search results.
+	SearchPager(req SearchRequest) *Pager[Result]
+	// SearchAll calls fn for every result of all pages, starting from req.Page.
+	// It stops at the first error returned by fn.
+	SearchAll(ctx context.Context, req SearchRequest, fn func(Result) error) error
 }
 
 // searchService ...
 type searchService struct {
+	*client
 	url string
 }
 
-func newSearchService(url string) SearchService {
+func newSearchService(c *client, url string) SearchService {
 	return &searchService{
-		url: url,
+		client: c,
+		url:    url,
 	}
 }
 
+// SearchType is a type of search results.
+type SearchType string
+
+// Search types.
+const (
+	SearchRelease SearchType = "release"
+	SearchMaster  SearchType = "master"
+	SearchArtist  SearchType = "artist"
+	SearchLabel   SearchType = "label"
+)
+
 // SearchRequest describes search request
 type SearchRequest struct {
-	Q            string // search query
-	Type         string // one of release, master, artist, label
-	Title        string // search by combined “Artist Name - Release Title” title field
-	ReleaseTitle string // search release titles
-	Credit       string // search release credits
-	Artist       string // search artist names
-	Anv          string // search artist ANV
-	Label        string // search label names
-	Genre        string // search genres
-	Style        string // search styles
-	Country      string // search release country
-	Year         string // search release year
-	Format       string // search formats
-	Catno        string // search catalog number
-	Barcode      string // search barcodes
-	Track        string // search track titles
-	Submitter    string // search submitter username
-	Contributor  string // search contributor usernames
+	Q            string     // search query
+	Type         SearchType // one of release, master, artist, label
+	Title        string     // search by combined “Artist Name - Release Title” title field
+	ReleaseTitle string     // search release titles
+	Credit       string     // search release credits
+	Artist       string     // search artist names
+	Anv          string     // search artist ANV
+	Label        string     // search label names
+	Genre        string     // search genres
+	Style        string     // search styles
+	Country      string     // search release country
+	Year         string     // search release year
+	Format       string     // search formats
+	Catno        string     // search catalog number
+	Barcode      string     // search barcodes
+	Track        string     // search track titles
+	Submitter    string     // search submitter username
+	Contributor  string     // search contributor usernames
 
 	Page    int
 	PerPage int
@@ -61,7 +81,7 @@ func (r *SearchRequest) params() url.Values {
 		params.Set("q", r.Q)
 	}
 	if r.Type != "" {
-		params.Set("type", r.Type)
+		params.Set("type", string(r.Type))
 	}
 	if r.Title != "" {
 		params.Set("title", r.Title)
@@ -125,28 +145,124 @@ type Search struct {
 	Results    []Result `json:"results,omitempty"`
 }
 
-// Result describes a part of search result
+// Result describes a part of search result.
+// One of Release, Master, Artist and Label is set according to Type.
 type Result struct {
-	Style       []string  `json:"style,omitempty"`
-	Thumb       string    `json:"thumb,omitempty"`
-	CoverImage  string    `json:"cover_image,omitempty"`
-	Title       string    `json:"title,omitempty"`
-	Country     string    `json:"country,omitempty"`
-	Format      []string  `json:"format,omitempty"`
-	URI         string    `json:"uri,omitempty"`
-	Community   Community `json:"community,omitempty"`
-	Label       []string  `json:"label,omitempty"`
-	Catno       string    `json:"catno,omitempty"`
-	Year        string    `json:"year,omitempty"`
-	Genre       []string  `json:"genre,omitempty"`
-	ResourceURL string    `json:"resource_url,omitempty"`
-	Type        string    `json:"type,omitempty"`
-	ID          int       `json:"id,omitempty"`
-	MasterID    int       `json:"master_id,omitempty"`
-}
-
-func (s *searchService) Search(req SearchRequest) (*Search, error) {
+	Type    SearchType
+	Release *ReleaseResult
+	Master  *MasterResult
+	Artist  *ArtistResult
+	Label   *LabelResult
+}
+
+// ResultBase describes fields common for results of all types.
+type ResultBase struct {
+	ID          int        `json:"id,omitempty"`
+	Type        SearchType `json:"type,omitempty"`
+	Title       string     `json:"title,omitempty"`
+	Thumb       string     `json:"thumb,omitempty"`
+	CoverImage  string     `json:"cover_image,omitempty"`
+	URI         string     `json:"uri,omitempty"`
+	ResourceURL string     `json:"resource_url,omitempty"`
+}
+
+// ReleaseResultFields are fields common for results of releases and master releases.
+type ReleaseResultFields struct {
+	Style     []string         `json:"style,omitempty"`
+	Country   string           `json:"country,omitempty"`
+	Format    []string         `json:"format,omitempty"`
+	Community *ResultCommunity `json:"community,omitempty"`
+	Label     []string         `json:"label,omitempty"`
+	Catno     string           `json:"catno,omitempty"`
+	Year      string           `json:"year,omitempty"`
+	Genre     []string         `json:"genre,omitempty"`
+	Barcode   []string         `json:"barcode,omitempty"`
+	MasterID  int              `json:"master_id,omitempty"`
+	MasterURL string           `json:"master_url,omitempty"`
+}
+
+// ReleaseResult describes a release found by search.
+type ReleaseResult struct {
+	ResultBase
+	ReleaseResultFields
+}
+
+// MasterResult describes a master release found by search.
+type MasterResult struct {
+	ResultBase
+	ReleaseResultFields
+}
+
+// ResultCommunity describes how many users have and want a release.
+type ResultCommunity struct {
+	Have int `json:"have"`
+	Want int `json:"want"`
+}
+
+// ArtistResult describes an artist found by search.
+type ArtistResult struct {
+	ResultBase
+}
+
+// LabelResult describes a label found by search.
+type LabelResult struct {
+	ResultBase
+}
+
+// UnmarshalJSON decodes a result into a struct of its type.
+func (r *Result) UnmarshalJSON(b []byte) error {
+	var base ResultBase
+	if err := json.Unmarshal(b, &base); err != nil {
+		return err
+	}
+
+	*r = Result{Type: base.Type}
+	switch base.Type {
+	case SearchRelease:
+		r.Release = &ReleaseResult{}
+		return json.Unmarshal(b, r.Release)
+	case SearchMaster:
+		r.Master = &MasterResult{}
+		return json.Unmarshal(b, r.Master)
+	case SearchArtist:
+		r.Artist = &ArtistResult{}
+		return json.Unmarshal(b, r.Artist)
+	case SearchLabel:
+		r.Label = &LabelResult{}
+		return json.Unmarshal(b, r.Label)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the result as discogs does.
+func (r Result) MarshalJSON() ([]byte, error) {
+	switch {
+	case r.Release != nil:
+		return json.Marshal(r.Release)
+	case r.Master != nil:
+		return json.Marshal(r.Master)
+	case r.Artist != nil:
+		return json.Marshal(r.Artist)
+	case r.Label != nil:
+		return json.Marshal(r.Label)
+	}
+	return json.Marshal(ResultBase{Type: r.Type})
+}
+
+func (s *searchService) Search(ctx context.Context, req SearchRequest) (*Search, error) {
 	var search *Search
-	err := request(s.url, req.params(), &search)
+	err := s.request(ctx, s.url, req.params(), &search)
 	return search, err
 }
+
+func (s *searchService) SearchPager(req SearchRequest) *Pager[Result] {
+	return newPager[Result](s.client, s.url, req.params(), "results")
+}
+
+func (s *searchService) SearchAll(ctx context.Context, req SearchRequest, fn func(Result) error) error {
+	if req.PerPage == 0 {
+		// fewer pages means fewer requests against the rate limit
+		req.PerPage = maxPerPage
+	}
+	return s.SearchPager(req).ForEach(ctx, fn)
+}
@@ -0,0 +1,95 @@
+package discogs
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func SearchServer(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" || r.URL.Path != "/database/search" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if q := r.URL.Query(); q.Get("q") != "nevermind" || q.Get("type") != "release" || q.Get("barcode") != "7 2064-24425-1 7" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.WriteString(w, searchJson); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+}
+
+func TestSearchServiceSearch(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(SearchServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+	search, err := d.Search(context.Background(), SearchRequest{Q: "nevermind", Type: SearchRelease, Barcode: "7 2064-24425-1 7"})
+	if err != nil {
+		t.Fatalf("failed to search: %s", err)
+	}
+
+	if len(search.Results) != 4 {
+		t.Fatalf("results got=%d; want=4", len(search.Results))
+	}
+	if r := search.Results[0]; r.Release == nil || r.Release.Catno != "DGC-24425" || r.Release.Community.Have != 4526 {
+		t.Errorf("release result got=%+v", r.Release)
+	}
+	if r := search.Results[1]; r.Master == nil || r.Master.Title != "Nirvana - Nevermind" {
+		t.Errorf("master result got=%+v", r.Master)
+	}
+	if r := search.Results[2]; r.Artist == nil || r.Artist.ID != 125246 {
+		t.Errorf("artist result got=%+v", r.Artist)
+	}
+	if r := search.Results[3]; r.Label == nil || r.Label.Title != "DGC" {
+		t.Errorf("label result got=%+v", r.Label)
+	}
+
+	json, err := json.Marshal(search)
+	if err != nil {
+		t.Fatalf("failed to marshal search: %s", err)
+	}
+
+	compareJson(t, string(json), searchJson)
+}
+
+func TestSearchServiceSearchAll(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		if r.URL.Query().Get("per_page") != "100" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch page {
+		case "0", "1":
+			w.WriteHeader(http.StatusOK)
+			_, _ = io.WriteString(w, `{"pagination": {"page": 1, "pages": 2, "urls": {"next": "https://api.discogs.com/database/search?q=nirvana&page=2&per_page=100"}}, "results": [{"id": 1, "type": "artist"}, {"id": 2, "type": "label"}]}`)
+		case "2":
+			w.WriteHeader(http.StatusOK)
+			_, _ = io.WriteString(w, `{"pagination": {"page": 2, "pages": 2, "urls": {}}, "results": [{"id": 3, "type": "release"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	var types []SearchType
+	err := d.SearchAll(context.Background(), SearchRequest{Q: "nirvana"}, func(r Result) error {
+		types = append(types, r.Type)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to search: %s", err)
+	}
+	if len(types) != 3 || types[0] != SearchArtist || types[1] != SearchLabel || types[2] != SearchRelease {
+		t.Errorf("results got=%v; want=[artist label release]", types)
+	}
+}
@@ -8,6 +8,14 @@ const releaseJson = `{"styles": ["Ska", "Reggae"], "videos": [{"duration": 301,
 
 const artistJson = `{"profile": "Marshall Bruce Mathers III (born October 17, 1972, St. Joseph, Missouri), known by his primary stage name Eminem, or by his alter ego Slim Shady, is an American rapper and record producer who grew up in Detroit, Michigan. He began his professional music career as a member of Soul Intent along with Proof in 1992. He also started his first record label with his group that same year called Mashin' Duck Records.", "realname": "Marshall Bruce Mathers III", "releases_url": "https://api.discogs.com/artists/38661/releases", "name": "Eminem", "uri": "https://www.discogs.com/artist/38661-Eminem", "urls": ["http://www.eminem.com", "http://www.instagram.com/eminem", "http://twitter.com/Eminem", "https://twitter.com/AskAboutREVIVAL", "http://www.facebook.com/eminem", "http://www.imdb.com/name/nm0004896", "http://www.myspace.com/eminem", "https://www.youtube.com/user/EminemMusic", "https://www.youtube.com/user/EminemVEVO", "https://www.filmo.gs/credit/16526-eminem", "https://www.bookogs.com/credit/229267-eminem", "http://eminem.tumblr.com", "http://en.wikipedia.org/wiki/Eminem", "http://equipboard.com/pros/eminem", "https://genius.com/eminem"], "images": [{"uri": "", "height": 607, "width": 600, "resource_url": "", "type": "primary", "uri150": ""}, {"uri": "", "height": 610, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 625, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 503, "width": 409, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 652, "width": 452, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 326, "width": 251, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 397, "width": 441, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 450, "width": 348, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 442, "width": 319, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 740, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 446, "width": 299, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 288, "width": 288, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 360, "width": 468, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 372, "width": 500, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 404, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 600, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 444, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 450, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 604, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 642, "width": 500, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 253, "width": 199, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 550, "width": 400, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 160, "width": 236, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 400, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 821, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 258, "width": 195, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 450, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 746, "width": 517, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 170, "width": 220, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 500, "width": 300, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 347, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 281, "width": 500, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 552, "width": 435, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 444, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 507, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 488, "width": 300, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 409, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 515, "width": 578, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 387, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 310, "width": 266, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 800, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 613, "width": 454, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 751, "width": 500, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 657, "width": 485, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 543, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 490, "width": 376, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 450, "width": 403, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 400, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 600, "width": 480, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 532, "width": 415, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 600, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 500, "width": 444, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 400, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 256, "width": 256, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 718, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 440, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 400, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 905, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 300, "width": 202, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 552, "width": 435, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 600, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 578, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}, {"uri": "", "height": 600, "width": 600, "resource_url": "", "type": "secondary", "uri150": ""}], "resource_url": "https://api.discogs.com/artists/38661", "aliases": [{"resource_url": "https://api.discogs.com/artists/108184", "id": 108184, "name": "Slim Shady"}, {"resource_url": "https://api.discogs.com/artists/644153", "id": 644153, "name": "Marshall Mathers"}, {"resource_url": "https://api.discogs.com/artists/787714", "id": 787714, "name": "Ken Kaniff"}], "id": 38661, "data_quality": "Needs Vote", "namevariations": ["E. Minem", "Em", "Emiem", "Emine", "EMINEM", "Eminem Show", "Eminen", "Enimen", "M & M", "M. Mathers", "M.N.M", "M&M", "MC Double M", "\u30a8\u30df\u30cd\u30e0"]}`
 
+const collectionFieldsJson = `{"fields": [{"id": 1, "name": "Media Condition", "type": "dropdown", "position": 1, "public": true, "options": ["Mint (M)", "Near Mint (NM or M-)", "Very Good Plus (VG+)", "Very Good (VG)", "Good Plus (G+)", "Good (G)", "Fair (F)", "Poor (P)"]}, {"id": 3, "name": "Notes", "type": "textarea", "position": 3, "public": false, "lines": 3}]}`
+
+const collectionValueJson = `{"minimum": "$601.30", "median": "$1,202.59", "maximum": "$2,405.18"}`
+
+const newFolderJson = `{"id": 2, "name": "Vinyl", "count": 0, "resource_url": "https://api.discogs.com/users/test_user/collection/folders/2"}`
+
+const collectionInstanceJson = `{"instance_id": 1, "resource_url": "https://api.discogs.com/users/test_user/collection/folders/1/releases/130076/instances/1"}`
+
 const folderJson = `{"id": 0, "name": "All", "count": 95, "resource_url": "https://api.discogs.com/users/test_user/collection/folders/0"}`
 
 const collectionJson = `{"folders": [{"id": 0, "name": "All", "count": 95, "resource_url": "https://api.discogs.com/users/test_user/collection/folders/0"}]}`
@@ -18,4 +26,58 @@ const collectionItemsByRelease = `{"pagination": {"page": 1, "pages": 1, "per_pa
 
 const priceSuggestionJson = `{"Mint (M)": {"currency": "EUR", "value": 16.625}, "Near Mint (NM or M-)": {"currency": "EUR", "value": 14.875000000000002}, "Very Good Plus (VG+)": {"currency": "EUR", "value": 11.375000000000002}, "Very Good (VG)": {"currency": "EUR", "value": 7.875000000000001}, "Good Plus (G+)": {"currency": "EUR", "value": 4.375}, "Good (G)": {"currency": "EUR", "value": 2.625}, "Fair (F)": {"currency": "EUR", "value": 1.7500000000000002}, "Poor (P)": {"currency": "EUR", "value": 0.8750000000000001}}`
 
+const feeJson = `{"value": 0.8, "currency": "USD"}`
+
 const releaseStatsJson = `{"num_for_sale": 4, "lowest_price": {"value": 18.07, "currency": "USD"}, "blocked_from_sale": false}`
+
+const wantlistJson = `{"pagination": {"per_page": 50, "items": 1, "page": 1, "pages": 1, "urls": {}}, "wants": [{"id": 1867708, "rating": 4, "notes": "", "resource_url": "https://api.discogs.com/users/test_user/wants/1867708", "date_added": "2014-09-29T02:58:03-07:00", "basic_information": {"id": 1867708, "master_id": 15541, "master_url": "https://api.discogs.com/masters/15541", "resource_url": "https://api.discogs.com/releases/1867708", "thumb": "", "cover_image": "", "title": "Year Zero", "year": 2007, "formats": [{"name": "Vinyl", "qty": "2", "descriptions": ["LP", "Album"]}], "labels": [{"name": "Interscope Records", "catno": "B0008764-01", "entity_type": "1", "entity_type_name": "Label", "id": 1000, "resource_url": "https://api.discogs.com/labels/1000"}], "artists": [{"name": "Nine Inch Nails", "anv": "", "join": "", "role": "", "tracks": "", "id": 3857, "resource_url": "https://api.discogs.com/artists/3857"}], "genres": ["Electronic", "Rock"], "styles": ["Industrial"]}}]}`
+
+const wantJson = `{"id": 1867708, "rating": 4, "notes": "Pressing with the blue label", "resource_url": "https://api.discogs.com/users/test_user/wants/1867708", "basic_information": {"id": 1867708, "master_id": 15541, "master_url": "https://api.discogs.com/masters/15541", "resource_url": "https://api.discogs.com/releases/1867708", "thumb": "", "cover_image": "", "title": "Year Zero", "year": 2007, "formats": [{"name": "Vinyl", "qty": "2", "descriptions": ["LP", "Album"]}], "labels": [{"name": "Interscope Records", "catno": "B0008764-01", "entity_type": "1", "entity_type_name": "Label", "id": 1000, "resource_url": "https://api.discogs.com/labels/1000"}], "artists": [{"name": "Nine Inch Nails", "anv": "", "join": "", "role": "", "tracks": "", "id": 3857, "resource_url": "https://api.discogs.com/artists/3857"}], "genres": ["Electronic", "Rock"], "styles": ["Industrial"]}}`
+
+const identityJson = `{"id": 1, "username": "test_user", "resource_url": "https://api.discogs.com/users/test_user", "consumer_name": "Test Application"}`
+
+const profileJson = `{"profile": "I am a software developer for Discogs.", "wantlist_url": "https://api.discogs.com/users/test_user/wants", "rank": 149, "num_pending": 18, "id": 1578108, "num_for_sale": 0, "home_page": "", "location": "Portland, Oregon", "collection_folders_url": "https://api.discogs.com/users/test_user/collection/folders", "username": "test_user", "collection_fields_url": "https://api.discogs.com/users/test_user/collection/fields", "releases_contributed": 5, "registered": "2012-08-15T21:13:36-07:00", "rating_avg": 3.47, "num_collection": 95, "releases_rated": 116, "num_lists": 0, "name": "Test User", "num_wantlist": 5, "inventory_url": "https://api.discogs.com/users/test_user/inventory", "avatar_url": "", "banner_url": "", "uri": "https://www.discogs.com/user/test_user", "resource_url": "https://api.discogs.com/users/test_user", "buyer_rating": 100.0, "buyer_rating_stars": 5, "buyer_num_ratings": 144, "seller_rating": 100.0, "seller_rating_stars": 5, "seller_num_ratings": 21, "curr_abbr": "USD"}`
+
+const userListsJson = `{"pagination": {"per_page": 50, "items": 1, "page": 1, "pages": 1, "urls": {}}, "lists": [{"date_added": "2015-06-14T10:01:21-07:00", "date_changed": "2015-09-09T14:48:43-07:00", "name": "My Favorites", "id": 2, "uri": "https://www.discogs.com/lists/My-Favorites/2", "resource_url": "https://api.discogs.com/lists/2", "public": true, "description": "My favorite releases"}]}`
+
+const listJson = `{"created_ts": "2015-06-14T10:01:21-07:00", "modified_ts": "2015-09-09T14:48:43-07:00", "name": "My Favorites", "list_id": 2, "url": "https://www.discogs.com/lists/My-Favorites/2", "items": [{"comment": "My favorite release", "display_title": "Silent Phase - The Rewired Mixes", "uri": "https://www.discogs.com/Silent-Phase-The-Rewired-Mixes/release/1", "image_url": "", "resource_url": "https://api.discogs.com/releases/1", "type": "release", "id": 1}], "resource_url": "https://api.discogs.com/lists/2", "public": true, "description": "My favorite releases"}`
+
+const submissionsJson = `{"pagination": {"per_page": 50, "items": 3, "page": 1, "pages": 1, "urls": {}}, "submissions": {"artists": [{"id": 240177, "name": "Chuck Carr", "resource_url": "https://api.discogs.com/artists/240177"}], "labels": [{"id": 382268, "name": "Stone Age Records", "resource_url": "https://api.discogs.com/labels/382268"}], "releases": [{"id": 1010, "title": "Shima Eco Tones", "year": 2004, "resource_url": "https://api.discogs.com/releases/1010"}]}}`
+
+const contributionsJson = `{"pagination": {"per_page": 50, "items": 1, "page": 1, "pages": 1, "urls": {}}, "contributions": [{"id": 1010, "title": "Shima Eco Tones", "year": 2004, "resource_url": "https://api.discogs.com/releases/1010"}]}`
+
+const newListingJson = `{"listing_id": 41578241, "resource_url": "https://api.discogs.com/marketplace/listings/41578241"}`
+
+const orderJson = `{"id": "1-1", "resource_url": "https://api.discogs.com/marketplace/orders/1-1", "messages_url": "https://api.discogs.com/marketplace/orders/1-1/messages", "uri": "https://www.discogs.com/orders/1-1", "status": "Invoice Sent", "next_status": ["New Order", "Buyer Contacted", "Invoice Sent", "Payment Pending", "Payment Received", "Shipped", "Cancelled (Non-Paying Buyer)", "Cancelled (Item Unavailable)", "Cancelled (Per Buyer's Request)"], "fee": {"currency": "USD", "value": 2.52}, "created": "2011-10-21T09:25:17-07:00", "items": [{"release": {"id": 1, "description": "Persuader, The - Stockholm (2x12\")"}, "price": {"currency": "USD", "value": 42.0}, "media_condition": "Mint (M)", "sleeve_condition": "Mint (M)", "id": 41578242}], "shipping": {"currency": "USD", "method": "Standard", "value": 0.0}, "shipping_address": "Asdf Exampleton\n234 NE Asdf St.\nAsdf Town, Oregon, 14423\nUnited States", "additional_instructions": "please use sturdy packaging.", "archived": false, "seller": {"resource_url": "https://api.discogs.com/users/example_seller", "username": "example_seller", "id": 1}, "last_activity": "2011-10-21T09:25:17-07:00", "buyer": {"resource_url": "https://api.discogs.com/users/example_buyer", "username": "example_buyer", "id": 2}, "total": {"currency": "USD", "value": 42.0}}`
+
+const ordersJson = `{"pagination": {"per_page": 50, "items": 1, "page": 1, "pages": 1, "urls": {}}, "orders": [` + orderJson + `]}`
+
+const orderMessageJson = `{"timestamp": "2011-11-04T10:01:00-07:00", "message": "Thank you for your order!", "type": "message", "order": {"resource_url": "https://api.discogs.com/marketplace/orders/1-1", "id": "1-1"}, "subject": "Discogs Order #1-1, Stockholm", "from": {"id": 1, "resource_url": "https://api.discogs.com/users/example_seller", "username": "example_seller"}}`
+
+const orderMessagesJson = `{"pagination": {"per_page": 50, "items": 1, "page": 1, "pages": 1, "urls": {}}, "messages": [` + orderMessageJson + `]}`
+
+const inventoryExportJson = `{"status": "success", "created_ts": "2018-09-27T12:59:02", "url": "https://api.discogs.com/inventory/export/599632", "finished_ts": "2018-09-27T12:59:02", "download_url": "https://api.discogs.com/inventory/export/599632/download", "filename": "test_user-inventory-20180927-1259.csv", "id": 599632}`
+
+const inventoryExportsJson = `{"items": [` + inventoryExportJson + `], "pagination": {"per_page": 50, "items": 1, "page": 1, "pages": 1, "urls": {}}}`
+
+const inventoryExportCSV = "listing_id,artist,title,label,catno,format,release_id,status,price,listed,comments,media_condition,sleeve_condition,accept_offer,external_id,weight,format_quantity,flat_shipping,location\n41578241,Persuader,Stockholm,Svek,SK032,2x12\",1,For Sale,42.00,2018-09-27 12:59:02,,Mint (M),Mint (M),N,,230,1,,\n"
+
+const inventoryUploadJson = `{"status": "success", "results": "CSV file contains 1 records.\nProcessed 1 records.", "created_ts": "2017-12-18T09:17:35", "finished_ts": "2017-12-18T09:17:36", "filename": "add.csv", "type": "add", "id": 119615}`
+
+const inventoryUploadsJson = `{"items": [` + inventoryUploadJson + `], "pagination": {"per_page": 50, "items": 1, "page": 1, "pages": 1, "urls": {}}}`
+
+const inventoryUploadCSV = "release_id,price,media_condition\n1,42.00,Mint (M)\n"
+
+const userReleaseRatingJson = `{"username": "test_user", "release_id": 249504, "rating": 5}`
+
+const databaseReleaseStatsJson = `{"num_have": 2315, "num_want": 467}`
+
+const masterVersionsJson = `{"pagination": {"per_page": 10, "items": 11, "page": 2, "urls": {}, "pages": 2}, "versions": [{"catno": "SKL 5002", "country": "UK", "format": "LP, Album, Mono", "id": 1295432, "label": "Decca", "released": "1969", "resource_url": "https://api.discogs.com/releases/1295432", "status": "Accepted", "thumb": "", "title": "Let It Bleed"}]}`
+
+const searchJson = `{"pagination": {"per_page": 4, "pages": 1, "page": 1, "items": 4, "urls": {}}, "results": [{"id": 2028757, "type": "release", "title": "Nirvana - Nevermind", "thumb": "https://i.discogs.com/R-2028757.jpeg", "cover_image": "https://i.discogs.com/R-2028757.jpeg", "uri": "/Nirvana-Nevermind/release/2028757", "resource_url": "https://api.discogs.com/releases/2028757", "style": ["Grunge"], "country": "US", "format": ["Vinyl", "LP", "Album"], "community": {"have": 4526, "want": 2484}, "label": ["DGC"], "catno": "DGC-24425", "year": "1991", "genre": ["Rock"], "barcode": ["7 2064-24425-1 7"], "master_id": 13814, "master_url": "https://api.discogs.com/masters/13814"}, {"id": 13814, "type": "master", "title": "Nirvana - Nevermind", "uri": "/Nirvana-Nevermind/master/13814", "resource_url": "https://api.discogs.com/masters/13814", "style": ["Grunge"], "country": "US", "format": ["Vinyl", "LP", "Album"], "community": {"have": 80543, "want": 41245}, "label": ["DGC"], "catno": "DGC-24425", "year": "1991", "genre": ["Rock"], "master_id": 13814, "master_url": "https://api.discogs.com/masters/13814"}, {"id": 125246, "type": "artist", "title": "Nirvana", "uri": "/artist/125246-Nirvana", "resource_url": "https://api.discogs.com/artists/125246"}, {"id": 1262, "type": "label", "title": "DGC", "uri": "/label/1262-DGC", "resource_url": "https://api.discogs.com/labels/1262"}]}`
+
+const collectionExportFoldersJson = `{"folders": [{"id": 0, "name": "All", "count": 2, "resource_url": "https://api.discogs.com/users/test_user/collection/folders/0"}, {"id": 1, "name": "Uncategorized", "count": 2, "resource_url": "https://api.discogs.com/users/test_user/collection/folders/1"}]}`
+
+const collectionExportItemsJson = `{"pagination": {"page": 1, "pages": 1, "per_page": 100, "items": 2, "urls": {}}, "releases": [{"id": 12934893, "instance_id": 431009995, "folder_id": 1, "date_added": "2020-01-19T14:19:11-08:00", "rating": 4, "notes": [{"field_id": 1, "value": "Near Mint (NM or M-)"}, {"field_id": 3, "value": "Purple vinyl"}], "basic_information": {"id": 12934893, "title": "Zonk", "year": 2018, "formats": [{"name": "Vinyl", "qty": "1", "descriptions": ["LP", "Album"]}], "labels": [{"name": "Permanent Record", "catno": "PR014"}], "artists": [{"name": "Zoo Lake"}]}}, {"id": 4825435, "instance_id": 146424864, "folder_id": 1, "date_added": "2015-11-08T14:42:02-08:00", "rating": 0, "basic_information": {"id": 4825435, "title": "Untitled", "year": 0, "formats": [{"name": "CD", "qty": "2", "descriptions": ["Compilation"]}], "labels": [{"name": "Not On Label", "catno": "none"}, {"name": "Self-released", "catno": "SR1"}], "artists": [{"name": "Various"}]}}]}`
+
+const inventoryJson = `{"pagination": {"per_page": 1, "items": 2, "page": 1, "urls": {"last": "https://api.discogs.com/users/test_user/inventory?page=2&per_page=1", "next": "https://api.discogs.com/users/test_user/inventory?page=2&per_page=1"}, "pages": 2}, "listings": [{"id": 150899904, "status": "For Sale", "price": {"currency": "USD", "value": 149.99}, "allow_offers": false, "condition": "Mint (M)", "sleeve_condition": "Mint (M)", "comments": "Brand new", "posted": "2014-07-01T10:20:17-07:00", "ships_from": "United States", "audio": false, "uri": "https://www.discogs.com/sell/item/150899904", "resource_url": "https://api.discogs.com/marketplace/listings/150899904", "seller": {"id": 1369620, "username": "test_user", "resource_url": "https://api.discogs.com/users/test_user"}, "release": {"id": 4674523, "description": "Zoo Lake - Zonk (LP, Album)", "artist": "Zoo Lake", "title": "Zonk", "format": "LP, Album", "catalog_number": "PR014", "year": 2018, "thumbnail": "", "resource_url": "https://api.discogs.com/releases/4674523"}}]}`
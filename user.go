@@ -0,0 +1,203 @@
+package discogs
+
+import (
+	"context"
+)
+
+// valid sort keys
+// https://www.discogs.com/developers/#page:user-identity,header:user-identity-user-contributions
+var validContributionsSort = newSortKeys(
+	SortByLabel,
+	SortByArtist,
+	SortByTitle,
+	SortByCatno,
+	SortByFormat,
+	SortByRating,
+	SortByYear,
+	SortByAdded,
+)
+
+// submissions can't be sorted, only pagination is accepted
+// https://www.discogs.com/developers/#page:user-identity,header:user-identity-user-submissions
+var validSubmissionsSort = newSortKeys()
+
+// UserService is an interface to work with user identity and profile.
+type UserService interface {
+	// Identity returns basic information about the authenticated user.
+	// Authentication is required.
+	Identity(ctx context.Context) (*Identity, error)
+	// Profile returns a user’s profile.
+	// Private fields like email are returned only to the profile owner.
+	Profile(ctx context.Context, username string) (*Profile, error)
+	// EditProfile changes a user’s profile and returns the result.
+	// Authentication as the profile owner is required.
+	EditProfile(ctx context.Context, username string, profile *ProfileRequest) (*Profile, error)
+	// Submissions returns a user’s submissions: edits of artists, labels and releases.
+	// Submissions can't be sorted, pagination with Sort or SortOrder set returns ErrInvalidSortKey or ErrInvalidSortOrder.
+	Submissions(ctx context.Context, username string, pagination *Pagination) (*Submissions, error)
+	// SubmissionsPager iterates over all pages of a user’s submissions, every item is a page of them.
+	SubmissionsPager(username string, pagination *Pagination) *Pager[SubmissionItems]
+	// Contributions returns releases a user has contributed to.
+	Contributions(ctx context.Context, username string, pagination *Pagination) (*Contributions, error)
+	// ContributionsPager iterates over all pages of a user’s contributions.
+	ContributionsPager(username string, pagination *Pagination) *Pager[Release]
+}
+
+type userService struct {
+	*client
+	url string
+}
+
+func newUserService(c *client, url string) UserService {
+	return &userService{
+		client: c,
+		url:    url,
+	}
+}
+
+// Identity is the authenticated user.
+type Identity struct {
+	ID           int    `json:"id"`
+	Username     string `json:"username"`
+	ResourceURL  string `json:"resource_url"`
+	ConsumerName string `json:"consumer_name"`
+}
+
+func (s *userService) Identity(ctx context.Context) (*Identity, error) {
+	var identity *Identity
+	err := s.request(ctx, s.url+"/oauth/identity", nil, &identity)
+	return identity, err
+}
+
+// Profile serves user profile response from discogs.
+type Profile struct {
+	ID                   int     `json:"id"`
+	Username             string  `json:"username"`
+	Name                 string  `json:"name"`
+	Email                string  `json:"email,omitempty"`
+	Profile              string  `json:"profile"`
+	HomePage             string  `json:"home_page"`
+	Location             string  `json:"location"`
+	Registered           string  `json:"registered"`
+	Rank                 float64 `json:"rank"`
+	NumPending           int     `json:"num_pending"`
+	NumForSale           int     `json:"num_for_sale"`
+	NumLists             int     `json:"num_lists"`
+	NumCollection        int     `json:"num_collection"`
+	NumWantlist          int     `json:"num_wantlist"`
+	ReleasesContributed  int     `json:"releases_contributed"`
+	ReleasesRated        int     `json:"releases_rated"`
+	RatingAvg            float64 `json:"rating_avg"`
+	BuyerRating          float64 `json:"buyer_rating"`
+	BuyerRatingStars     float64 `json:"buyer_rating_stars"`
+	BuyerNumRatings      int     `json:"buyer_num_ratings"`
+	SellerRating         float64 `json:"seller_rating"`
+	SellerRatingStars    float64 `json:"seller_rating_stars"`
+	SellerNumRatings     int     `json:"seller_num_ratings"`
+	Currency             string  `json:"curr_abbr"`
+	AvatarURL            string  `json:"avatar_url"`
+	BannerURL            string  `json:"banner_url"`
+	CollectionFieldsURL  string  `json:"collection_fields_url"`
+	CollectionFoldersURL string  `json:"collection_folders_url"`
+	InventoryURL         string  `json:"inventory_url"`
+	WantlistURL          string  `json:"wantlist_url"`
+	ResourceURL          string  `json:"resource_url"`
+	URI                  string  `json:"uri"`
+}
+
+func (s *userService) Profile(ctx context.Context, username string) (*Profile, error) {
+	if username == "" {
+		return nil, ErrInvalidUsername
+	}
+	var profile *Profile
+	err := s.request(ctx, s.url+"/users/"+username, nil, &profile)
+	return profile, err
+}
+
+// ProfileRequest describes profile fields to change.
+// Empty fields are left unchanged.
+type ProfileRequest struct {
+	Name     string   `json:"name,omitempty"`      // real name of the user
+	HomePage string   `json:"home_page,omitempty"` // user’s website
+	Location string   `json:"location,omitempty"`  // geographical location of the user
+	Profile  string   `json:"profile,omitempty"`   // biographical information about the user
+	Currency Currency `json:"curr_abbr,omitempty"` // currency for marketplace data
+}
+
+func (s *userService) EditProfile(ctx context.Context, username string, profile *ProfileRequest) (*Profile, error) {
+	if username == "" {
+		return nil, ErrInvalidUsername
+	}
+	if profile != nil && profile.Currency != "" {
+		if _, err := s.validCurrency(profile.Currency); err != nil {
+			return nil, err
+		}
+	}
+
+	body := struct {
+		Username string `json:"username"`
+		*ProfileRequest
+	}{username, profile}
+
+	var p *Profile
+	err := s.requestWithJSONBody(ctx, "POST", s.url+"/users/"+username, nil, body, &p)
+	return p, err
+}
+
+// Submissions is a list of a user’s submissions.
+type Submissions struct {
+	Pagination  Page            `json:"pagination"`
+	Submissions SubmissionItems `json:"submissions"`
+}
+
+// SubmissionItems are artists, labels and releases a user has submitted.
+type SubmissionItems struct {
+	Artists  []Artist  `json:"artists"`
+	Labels   []Label   `json:"labels"`
+	Releases []Release `json:"releases"`
+}
+
+func (s *userService) Submissions(ctx context.Context, username string, pagination *Pagination) (*Submissions, error) {
+	if err := validateUserList(username, pagination, validSubmissionsSort); err != nil {
+		return nil, err
+	}
+	var submissions *Submissions
+	err := s.request(ctx, s.url+"/users/"+username+"/submissions", pagination.params(), &submissions)
+	return submissions, err
+}
+
+func (s *userService) SubmissionsPager(username string, pagination *Pagination) *Pager[SubmissionItems] {
+	if err := validateUserList(username, pagination, validSubmissionsSort); err != nil {
+		return errPager[SubmissionItems](err)
+	}
+	return newObjectPager[SubmissionItems](s.client, s.url+"/users/"+username+"/submissions", pagination.params(), "submissions")
+}
+
+// Contributions is a list of releases a user has contributed to.
+type Contributions struct {
+	Pagination    Page      `json:"pagination"`
+	Contributions []Release `json:"contributions"`
+}
+
+func (s *userService) Contributions(ctx context.Context, username string, pagination *Pagination) (*Contributions, error) {
+	if err := validateUserList(username, pagination, validContributionsSort); err != nil {
+		return nil, err
+	}
+	var contributions *Contributions
+	err := s.request(ctx, s.url+"/users/"+username+"/contributions", pagination.params(), &contributions)
+	return contributions, err
+}
+
+func (s *userService) ContributionsPager(username string, pagination *Pagination) *Pager[Release] {
+	if err := validateUserList(username, pagination, validContributionsSort); err != nil {
+		return errPager[Release](err)
+	}
+	return newPager[Release](s.client, s.url+"/users/"+username+"/contributions", pagination.params(), "contributions")
+}
+
+func validateUserList(username string, pagination *Pagination, keys sortKeys) error {
+	if username == "" {
+		return ErrInvalidUsername
+	}
+	return pagination.validate(keys)
+}
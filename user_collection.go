@@ -1,35 +1,74 @@
 package discogs
 
 import (
+	"context"
+	"encoding/json"
+	"io"
 	"net/url"
 	"strconv"
+	"strings"
+	"unicode"
 )
 
 // CollectionService is an interface to work with collection.
 type CollectionService interface {
 	// Retrieve a list of folders in a user’s collection.
 	// If folder_id is not 0, authentication as the collection owner is required.
-	CollectionFolders(username string) (*CollectionFolders, error)
+	CollectionFolders(ctx context.Context, username string) (*CollectionFolders, error)
 	// Retrieve a list of items in a folder in a user’s collection.
 	// If folderID is not 0, authentication with token is required.
-	CollectionItemsByFolder(username string, folderID int, pagination *Pagination) (*CollectionItems, error)
+	CollectionItemsByFolder(ctx context.Context, username string, folderID int, pagination *Pagination) (*CollectionItems, error)
+	// CollectionItemsByFolderPager iterates over all pages of items in a folder.
+	CollectionItemsByFolderPager(username string, folderID int, pagination *Pagination) *Pager[CollectionItemSource]
 	// Retrieve the user’s collection folders which contain a specified release.
 	// The releaseID must be non-zero.
-	CollectionItemsByRelease(username string, releaseID int) (*CollectionItems, error)
+	CollectionItemsByRelease(ctx context.Context, username string, releaseID int) (*CollectionItems, error)
 	// Retrieve metadata about a folder in a user’s collection.
-	Folder(username string, folderID int) (*Folder, error)
+	Folder(ctx context.Context, username string, folderID int) (*Folder, error)
+	// CreateFolder creates a new folder in a user’s collection.
+	// Authentication as the collection owner is required.
+	CreateFolder(ctx context.Context, username, name string) (*Folder, error)
+	// EditFolder renames a folder. Folders 0 (All) and 1 (Uncategorized) can't be renamed.
+	// Authentication as the collection owner is required.
+	EditFolder(ctx context.Context, username string, folderID int, name string) (*Folder, error)
+	// DeleteFolder deletes an empty folder. Folders 0 (All) and 1 (Uncategorized) can't be deleted.
+	// Authentication as the collection owner is required.
+	DeleteFolder(ctx context.Context, username string, folderID int) error
+	// AddToCollectionFolder adds a release to a folder in a user’s collection.
+	// The folderID must be non-zero, use 1 (Uncategorized) by default.
+	// Authentication as the collection owner is required.
+	AddToCollectionFolder(ctx context.Context, username string, folderID, releaseID int) (*CollectionInstance, error)
+	// EditCollectionInstance changes the rating of a release instance and/or moves it to another folder.
+	// The folderID is the folder the instance is in and must be non-zero.
+	// Authentication as the collection owner is required.
+	EditCollectionInstance(ctx context.Context, username string, folderID, releaseID, instanceID int, instance *InstanceRequest) error
+	// DeleteCollectionInstance removes an instance of a release from a user’s collection.
+	// The folderID is the folder the instance is in and must be non-zero.
+	// Authentication as the collection owner is required.
+	DeleteCollectionInstance(ctx context.Context, username string, folderID, releaseID, instanceID int) error
+	// CollectionFields retrieves a list of user-defined collection notes fields.
+	// Private fields are returned only if authenticated as the collection owner.
+	CollectionFields(ctx context.Context, username string) (*CollectionFields, error)
 	// Change the value of a notes field (including media/sleeve condition) on a particular instance.
-	// fieldID 0 = Media Condition, 1 = Sleeve Condition, 3+ = Notes fields.
-	EditFieldsInstance(username string, folderID, releaseID, instanceID int, fieldID FieldID, value string) error
+	// fieldID 1 = Media Condition, 2 = Sleeve Condition, 3+ = Notes fields.
+	EditFieldsInstance(ctx context.Context, username string, folderID, releaseID, instanceID int, fieldID FieldID, value string) error
+	// CollectionValue returns the minimum, median and maximum estimated value of a user’s collection.
+	// Authentication as the collection owner is required.
+	CollectionValue(ctx context.Context, username string) (*CollectionValue, error)
+	// ExportCollection writes all items of a folder to w as CSV or JSON,
+	// with the columns of the export discogs provides on the website.
+	ExportCollection(ctx context.Context, username string, folderID int, w io.Writer, format ExportFormat) error
 }
 
 type collectionService struct {
+	*client
 	url string
 }
 
-func newCollectionService(url string) CollectionService {
+func newCollectionService(c *client, url string) CollectionService {
 	return &collectionService{
-		url: url,
+		client: c,
+		url:    url,
 	}
 }
 
@@ -41,26 +80,69 @@ type Folder struct {
 	ResourceURL string `json:"resource_url"`
 }
 
-func (s *collectionService) Folder(username string, folderID int) (*Folder, error) {
+func (s *collectionService) Folder(ctx context.Context, username string, folderID int) (*Folder, error) {
 	if username == "" {
 		return nil, ErrInvalidUsername
 	}
 	var folder *Folder
-	err := request(s.url+"/"+username+"/collection/folders/"+strconv.Itoa(folderID), nil, &folder)
+	err := s.request(ctx, s.url+"/"+username+"/collection/folders/"+strconv.Itoa(folderID), nil, &folder)
 	return folder, err
 }
 
+func (s *collectionService) CreateFolder(ctx context.Context, username, name string) (*Folder, error) {
+	if username == "" {
+		return nil, ErrInvalidUsername
+	}
+	if name == "" {
+		return nil, ErrInvalidFolderName
+	}
+	var folder *Folder
+	err := s.requestWithJSONBody(ctx, "POST", s.url+"/"+username+"/collection/folders", nil, map[string]string{"name": name}, &folder)
+	return folder, err
+}
+
+func (s *collectionService) EditFolder(ctx context.Context, username string, folderID int, name string) (*Folder, error) {
+	if username == "" {
+		return nil, ErrInvalidUsername
+	}
+	if !editableFolder(folderID) {
+		return nil, ErrInvalidFolderID
+	}
+	if name == "" {
+		return nil, ErrInvalidFolderName
+	}
+	var folder *Folder
+	err := s.requestWithJSONBody(ctx, "POST", s.url+"/"+username+"/collection/folders/"+strconv.Itoa(folderID), nil, map[string]string{"name": name}, &folder)
+	return folder, err
+}
+
+func (s *collectionService) DeleteFolder(ctx context.Context, username string, folderID int) error {
+	if username == "" {
+		return ErrInvalidUsername
+	}
+	if !editableFolder(folderID) {
+		return ErrInvalidFolderID
+	}
+	return s.requestWithMethod(ctx, "DELETE", s.url+"/"+username+"/collection/folders/"+strconv.Itoa(folderID), nil, nil)
+}
+
+// editableFolder reports whether a folder may be renamed or deleted.
+// Folders 0 (All) and 1 (Uncategorized) are managed by discogs.
+func editableFolder(folderID int) bool {
+	return folderID > 1
+}
+
 // CollectionFolders serves collection response from discogs.
 type CollectionFolders struct {
 	Folders []Folder `json:"folders"`
 }
 
-func (s *collectionService) CollectionFolders(username string) (*CollectionFolders, error) {
+func (s *collectionService) CollectionFolders(ctx context.Context, username string) (*CollectionFolders, error) {
 	if username == "" {
 		return nil, ErrInvalidUsername
 	}
 	var collection *CollectionFolders
-	err := request(s.url+"/"+username+"/collection/folders", nil, &collection)
+	err := s.request(ctx, s.url+"/"+username+"/collection/folders", nil, &collection)
 	return collection, err
 }
 
@@ -100,44 +182,119 @@ type CollectionItems struct {
 
 // valid sort keys
 // https://www.discogs.com/developers#page:user-collection,header:user-collection-collection-items-by-folder
-var validItemsByFolderSort = map[string]struct{}{
-	"":       struct{}{},
-	"label":  struct{}{},
-	"artist": struct{}{},
-	"title":  struct{}{},
-	"catno":  struct{}{},
-	"format": struct{}{},
-	"rating": struct{}{},
-	"added":  struct{}{},
-	"year":   struct{}{},
-}
-
-func (s *collectionService) CollectionItemsByFolder(username string, folderID int, pagination *Pagination) (*CollectionItems, error) {
+var validItemsByFolderSort = newSortKeys(
+	SortByLabel,
+	SortByArtist,
+	SortByTitle,
+	SortByCatno,
+	SortByFormat,
+	SortByRating,
+	SortByAdded,
+	SortByYear,
+)
+
+func (s *collectionService) CollectionItemsByFolder(ctx context.Context, username string, folderID int, pagination *Pagination) (*CollectionItems, error) {
+	if err := validateItemsByFolder(username, pagination); err != nil {
+		return nil, err
+	}
+	var items *CollectionItems
+	err := s.request(ctx, s.url+"/"+username+"/collection/folders/"+strconv.Itoa(folderID)+"/releases", pagination.params(), &items)
+	return items, err
+}
+
+func (s *collectionService) CollectionItemsByFolderPager(username string, folderID int, pagination *Pagination) *Pager[CollectionItemSource] {
+	if err := validateItemsByFolder(username, pagination); err != nil {
+		return errPager[CollectionItemSource](err)
+	}
+	return newPager[CollectionItemSource](s.client, s.url+"/"+username+"/collection/folders/"+strconv.Itoa(folderID)+"/releases", pagination.params(), "releases")
+}
+
+func validateItemsByFolder(username string, pagination *Pagination) error {
+	if username == "" {
+		return ErrInvalidUsername
+	}
+	return pagination.validate(validItemsByFolderSort)
+}
+
+func (s *collectionService) CollectionItemsByRelease(ctx context.Context, username string, releaseID int) (*CollectionItems, error) {
 	if username == "" {
 		return nil, ErrInvalidUsername
 	}
-	if pagination != nil {
-		if _, ok := validItemsByFolderSort[pagination.Sort]; !ok {
-			return nil, ErrInvalidSortKey
-		}
+	if releaseID == 0 {
+		return nil, ErrInvalidReleaseID
 	}
 	var items *CollectionItems
-	err := request(s.url+"/"+username+"/collection/folders/"+strconv.Itoa(folderID)+"/releases", pagination.params(), &items)
+	err := s.request(ctx, s.url+"/"+username+"/collection/releases/"+strconv.Itoa(releaseID), nil, &items)
 	return items, err
 }
 
-func (s *collectionService) CollectionItemsByRelease(username string, releaseID int) (*CollectionItems, error) {
+// CollectionInstance is an instance of a release added to a collection.
+type CollectionInstance struct {
+	InstanceID  int    `json:"instance_id"`
+	ResourceURL string `json:"resource_url"`
+}
+
+// InstanceRequest describes changes of a release instance in a collection.
+type InstanceRequest struct {
+	Rating   *int `json:"rating,omitempty"`    // rating between 1 and 5, 0 clears the rating (optional)
+	FolderID int  `json:"folder_id,omitempty"` // folder to move the instance to (optional)
+}
+
+func (s *collectionService) AddToCollectionFolder(ctx context.Context, username string, folderID, releaseID int) (*CollectionInstance, error) {
 	if username == "" {
 		return nil, ErrInvalidUsername
 	}
+	if folderID == 0 {
+		return nil, ErrInvalidFolderID
+	}
 	if releaseID == 0 {
 		return nil, ErrInvalidReleaseID
 	}
-	var items *CollectionItems
-	err := request(s.url+"/"+username+"/collection/releases/"+strconv.Itoa(releaseID), nil, &items)
-	return items, err
+	var instance *CollectionInstance
+	err := s.requestWithMethod(ctx, "POST", s.url+"/"+username+"/collection/folders/"+strconv.Itoa(folderID)+"/releases/"+strconv.Itoa(releaseID), nil, &instance)
+	return instance, err
+}
+
+func (s *collectionService) EditCollectionInstance(ctx context.Context, username string, folderID, releaseID, instanceID int, instance *InstanceRequest) error {
+	path, err := s.instancePath(username, folderID, releaseID, instanceID)
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		instance = &InstanceRequest{}
+	}
+	if r := instance.Rating; r != nil && (*r < 0 || *r > 5) {
+		return ErrInvalidRating
+	}
+	return s.requestWithJSONBody(ctx, "POST", path, nil, instance, nil)
+}
+
+func (s *collectionService) DeleteCollectionInstance(ctx context.Context, username string, folderID, releaseID, instanceID int) error {
+	path, err := s.instancePath(username, folderID, releaseID, instanceID)
+	if err != nil {
+		return err
+	}
+	return s.requestWithMethod(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *collectionService) instancePath(username string, folderID, releaseID, instanceID int) (string, error) {
+	if username == "" {
+		return "", ErrInvalidUsername
+	}
+	// instances are in a folder of their own, never in the All folder
+	if folderID == 0 {
+		return "", ErrInvalidFolderID
+	}
+	if releaseID == 0 {
+		return "", ErrInvalidReleaseID
+	}
+	if instanceID == 0 {
+		return "", ErrInvalidInstanceID
+	}
+	return s.url + "/" + username + "/collection/folders/" + strconv.Itoa(folderID) + "/releases/" + strconv.Itoa(releaseID) + "/instances/" + strconv.Itoa(instanceID), nil
+}
+
+// FieldID is an ID of a collection notes field.
 type FieldID int
 
 const (
@@ -146,15 +303,128 @@ const (
 	NotesField           FieldID = 3
 )
 
-func (s *collectionService) EditFieldsInstance(username string, folderID, releaseID, instanceID int, fieldID FieldID, value string) error {
+// CollectionField is a user-defined collection notes field.
+type CollectionField struct {
+	ID       FieldID  `json:"id"`
+	Name     string   `json:"name"`
+	Type     string   `json:"type"` // "dropdown" or "textarea"
+	Position int      `json:"position"`
+	Public   bool     `json:"public"`
+	Options  []string `json:"options,omitempty"` // values of a dropdown field
+	Lines    int      `json:"lines,omitempty"`   // height of a textarea field
+}
+
+// CollectionFields is a list of user-defined collection notes fields.
+type CollectionFields struct {
+	Fields []CollectionField `json:"fields"`
+}
+
+func (s *collectionService) CollectionFields(ctx context.Context, username string) (*CollectionFields, error) {
+	if username == "" {
+		return nil, ErrInvalidUsername
+	}
+	var fields *CollectionFields
+	err := s.request(ctx, s.url+"/"+username+"/collection/fields", nil, &fields)
+	return fields, err
+}
+
+func (s *collectionService) EditFieldsInstance(ctx context.Context, username string, folderID, releaseID, instanceID int, fieldID FieldID, value string) error {
+	path, err := s.instancePath(username, folderID, releaseID, instanceID)
+	if err != nil {
+		return err
+	}
+	if fieldID <= 0 {
+		return ErrInvalidFieldID
+	}
 	params := url.Values{}
 	params.Set("value", value)
-	err := requestWithJSONBody(
+	return s.requestWithJSONBody(
+		ctx,
 		"POST",
-		s.url+"/"+username+"/collection/folders/"+strconv.Itoa(folderID)+"/releases/"+strconv.Itoa(releaseID)+"/instances/"+strconv.Itoa(instanceID)+"/fields/"+strconv.Itoa(int(fieldID)),
+		path+"/fields/"+strconv.Itoa(int(fieldID)),
 		params,
 		map[string]string{"value": value},
 		nil,
 	)
-	return err
+}
+
+// CollectionValue is the estimated value of a user’s collection.
+type CollectionValue struct {
+	Minimum Price `json:"minimum"`
+	Median  Price `json:"median"`
+	Maximum Price `json:"maximum"`
+}
+
+func (s *collectionService) CollectionValue(ctx context.Context, username string) (*CollectionValue, error) {
+	if username == "" {
+		return nil, ErrInvalidUsername
+	}
+	var value *CollectionValue
+	err := s.request(ctx, s.url+"/"+username+"/collection/value", nil, &value)
+	return value, err
+}
+
+// currencySymbols maps symbols discogs formats prices with to currency codes.
+var currencySymbols = map[string]Currency{
+	"$":   CurrencyUSD,
+	"£":   CurrencyGBP,
+	"€":   CurrencyEUR,
+	"CA$": CurrencyCAD,
+	"A$":  CurrencyAUD,
+	"¥":   CurrencyJPY,
+	"CHF": CurrencyCHF,
+	"MX$": CurrencyMXN,
+	"R$":  CurrencyBRL,
+	"NZ$": CurrencyNZD,
+	"SEK": CurrencySEK,
+	"R":   CurrencyZAR,
+	"DKK": CurrencyDKK,
+}
+
+// Price is a price formatted by discogs, e.g. "$1,202.59".
+type Price struct {
+	// Value is the parsed amount.
+	Value float64
+	// Currency is the currency code, or the symbol as is if it's unknown.
+	Currency Currency
+	// Raw is the price as discogs formatted it.
+	Raw string
+}
+
+// UnmarshalJSON parses a formatted price string.
+func (p *Price) UnmarshalJSON(b []byte) error {
+	var raw string
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	amount := strings.TrimFunc(raw, func(r rune) bool { return !unicode.IsDigit(r) })
+	start := strings.Index(raw, amount)
+	prefix, suffix := raw[:start], raw[start+len(amount):]
+
+	var value float64
+	if amount != "" {
+		var err error
+		if value, err = strconv.ParseFloat(strings.ReplaceAll(amount, ",", ""), 64); err != nil {
+			return err
+		}
+	}
+	if strings.Contains(prefix, "-") {
+		value = -value
+		prefix = strings.Replace(prefix, "-", "", 1)
+	}
+
+	symbol := strings.TrimSpace(prefix + suffix)
+	currency, ok := currencySymbols[symbol]
+	if !ok {
+		currency = Currency(symbol)
+	}
+
+	*p = Price{Value: value, Currency: currency, Raw: raw}
+	return nil
+}
+
+// MarshalJSON returns the price as discogs formatted it.
+func (p Price) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.Raw)
 }
@@ -0,0 +1,189 @@
+package discogs
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExportFormat is a format of a collection export.
+type ExportFormat string
+
+// Export formats.
+const (
+	ExportCSV  ExportFormat = "csv"
+	ExportJSON ExportFormat = "json"
+)
+
+// exportColumns are columns of the collection export discogs provides on the website.
+var exportColumns = []string{
+	"Catalog#",
+	"Artist",
+	"Title",
+	"Label",
+	"Format",
+	"Rating",
+	"Released",
+	"release_id",
+	"CollectionFolder",
+	"Date Added",
+	"Collection Media Condition",
+	"Collection Sleeve Condition",
+	"Collection Notes",
+}
+
+// exportDateLayout is a layout of the Date Added column.
+const exportDateLayout = "2006-01-02 15:04:05"
+
+func (s *collectionService) ExportCollection(ctx context.Context, username string, folderID int, w io.Writer, format ExportFormat) error {
+	var exporter collectionExporter
+	switch format {
+	case ExportCSV:
+		exporter = &csvExporter{w: csv.NewWriter(w)}
+	case ExportJSON:
+		exporter = &jsonExporter{w: w}
+	default:
+		return ErrInvalidExportFormat
+	}
+
+	if username == "" {
+		return ErrInvalidUsername
+	}
+	folders, err := s.CollectionFolders(ctx, username)
+	if err != nil {
+		return err
+	}
+	names := map[int]string{}
+	for _, f := range folders.Folders {
+		names[f.ID] = f.Name
+	}
+
+	if err := exporter.begin(); err != nil {
+		return err
+	}
+	pager := s.CollectionItemsByFolderPager(username, folderID, &Pagination{PerPage: maxPerPage})
+	err = pager.ForEach(ctx, func(item CollectionItemSource) error {
+		return exporter.write(exportRow(item, names[item.FolderID]))
+	})
+	if err != nil {
+		return err
+	}
+	return exporter.end()
+}
+
+// exportRow returns values of exportColumns for the item.
+func exportRow(item CollectionItemSource, folder string) []string {
+	info := item.BasicInformation
+
+	var artists, labels, catnos, formats []string
+	for _, a := range info.Artists {
+		artists = append(artists, a.Name)
+	}
+	for _, l := range info.Labels {
+		labels = append(labels, l.Name)
+		catnos = append(catnos, l.Catno)
+	}
+	for _, f := range info.Formats {
+		name := f.Name
+		if f.Qty != "" && f.Qty != "1" {
+			name = f.Qty + "x" + name
+		}
+		formats = append(formats, strings.Join(append([]string{name}, f.Descriptions...), ", "))
+	}
+
+	released := ""
+	if info.Year != 0 {
+		released = strconv.Itoa(info.Year)
+	}
+	added := item.DateAdded
+	if t, err := time.Parse(time.RFC3339, item.DateAdded); err == nil {
+		added = t.Format(exportDateLayout)
+	}
+	notes := map[FieldID]string{}
+	for _, n := range item.Notes {
+		notes[n.FieldID] = n.Value
+	}
+
+	return []string{
+		strings.Join(catnos, ", "),
+		strings.Join(artists, ", "),
+		info.Title,
+		strings.Join(labels, ", "),
+		strings.Join(formats, " + "),
+		strconv.Itoa(item.Rating),
+		released,
+		strconv.Itoa(item.ID),
+		folder,
+		added,
+		notes[MediaConditionField],
+		notes[SleeveConditionField],
+		notes[NotesField],
+	}
+}
+
+// collectionExporter writes exported collection items.
+type collectionExporter interface {
+	begin() error
+	write(row []string) error
+	end() error
+}
+
+type csvExporter struct {
+	w *csv.Writer
+}
+
+func (e *csvExporter) begin() error {
+	return e.w.Write(exportColumns)
+}
+
+func (e *csvExporter) write(row []string) error {
+	return e.w.Write(row)
+}
+
+func (e *csvExporter) end() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// jsonExporter writes a JSON array of objects keyed by export columns.
+type jsonExporter struct {
+	w     io.Writer
+	count int
+}
+
+func (e *jsonExporter) begin() error {
+	_, err := io.WriteString(e.w, "[")
+	return err
+}
+
+func (e *jsonExporter) write(row []string) error {
+	// encode keys in order of columns, which a map doesn't keep
+	var b strings.Builder
+	if e.count > 0 {
+		b.WriteString(",")
+	}
+	b.WriteString("\n{")
+	for i, column := range exportColumns {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		key, _ := json.Marshal(column)
+		value, _ := json.Marshal(row[i])
+		b.Write(key)
+		b.WriteString(":")
+		b.Write(value)
+	}
+	b.WriteString("}")
+	e.count++
+	_, err := io.WriteString(e.w, b.String())
+	return err
+}
+
+func (e *jsonExporter) end() error {
+	_, err := io.WriteString(e.w, "\n]\n")
+	return err
+}
@@ -1,10 +1,12 @@
 package discogs
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -36,6 +38,20 @@ func CollectionServer(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+	case "/users/" + testUsername + "/collection/fields":
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, collectionFieldsJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+	case "/users/" + testUsername + "/collection/value":
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, collectionValueJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
 	case "/users/" + testUsername + "/collection/releases/12934893":
 		w.WriteHeader(http.StatusOK)
 		if _, err := io.WriteString(w, collectionItemsByRelease); err != nil {
@@ -73,13 +89,161 @@ func CollectionEditServer(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func CollectionFolderServer(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == "POST" && (r.URL.Path == "/users/"+testUsername+"/collection/folders" || r.URL.Path == "/users/"+testUsername+"/collection/folders/2"):
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["name"] != "Vinyl" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		if r.URL.Path == "/users/"+testUsername+"/collection/folders" {
+			w.WriteHeader(http.StatusCreated)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+		_, _ = io.WriteString(w, newFolderJson)
+	case r.Method == "DELETE" && r.URL.Path == "/users/"+testUsername+"/collection/folders/2":
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestCollectionServiceFolderCRUD(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(CollectionFolderServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+	ctx := context.Background()
+
+	folder, err := d.CreateFolder(ctx, testUsername, "Vinyl")
+	if err != nil {
+		t.Fatalf("failed to create folder: %s", err)
+	}
+	json, err := json.Marshal(folder)
+	if err != nil {
+		t.Fatalf("failed to marshal folder: %s", err)
+	}
+	compareJson(t, string(json), newFolderJson)
+
+	if _, err := d.EditFolder(ctx, testUsername, folder.ID, "Vinyl"); err != nil {
+		t.Fatalf("failed to edit folder: %s", err)
+	}
+	if err := d.DeleteFolder(ctx, testUsername, folder.ID); err != nil {
+		t.Fatalf("failed to delete folder: %s", err)
+	}
+}
+
+func TestCollectionServiceFolderCRUDErrors(t *testing.T) {
+	d := initDiscogsClient(t, nil)
+	ctx := context.Background()
+
+	if _, err := d.CreateFolder(ctx, testUsername, ""); err != ErrInvalidFolderName {
+		t.Errorf("create err got=%v; want=%s", err, ErrInvalidFolderName)
+	}
+	if _, err := d.EditFolder(ctx, testUsername, 1, "Vinyl"); err != ErrInvalidFolderID {
+		t.Errorf("edit err got=%v; want=%s", err, ErrInvalidFolderID)
+	}
+	if err := d.DeleteFolder(ctx, testUsername, 0); err != ErrInvalidFolderID {
+		t.Errorf("delete err got=%v; want=%s", err, ErrInvalidFolderID)
+	}
+	if err := d.DeleteFolder(ctx, "", 2); err != ErrInvalidUsername {
+		t.Errorf("delete err got=%v; want=%s", err, ErrInvalidUsername)
+	}
+}
+
+func CollectionInstanceServer(w http.ResponseWriter, r *http.Request) {
+	const release = "/users/" + testUsername + "/collection/folders/1/releases/130076"
+	switch {
+	case r.Method == "POST" && r.URL.Path == release:
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, collectionInstanceJson)
+	case r.Method == "POST" && r.URL.Path == release+"/instances/1":
+		var body map[string]int
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["rating"] != 5 || body["folder_id"] != 2 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == "POST" && r.URL.Path == release+"/instances/2":
+		// a cleared rating is sent as 0
+		var body map[string]int
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || len(body) != 1 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		if rating, ok := body["rating"]; !ok || rating != 0 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == "DELETE" && r.URL.Path == release+"/instances/1":
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestCollectionServiceCollectionInstance(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(CollectionInstanceServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+	ctx := context.Background()
+
+	instance, err := d.AddToCollectionFolder(ctx, testUsername, 1, 130076)
+	if err != nil {
+		t.Fatalf("failed to add release: %s", err)
+	}
+	json, err := json.Marshal(instance)
+	if err != nil {
+		t.Fatalf("failed to marshal instance: %s", err)
+	}
+	compareJson(t, string(json), collectionInstanceJson)
+
+	rating := 5
+	if err := d.EditCollectionInstance(ctx, testUsername, 1, 130076, instance.InstanceID, &InstanceRequest{Rating: &rating, FolderID: 2}); err != nil {
+		t.Fatalf("failed to edit instance: %s", err)
+	}
+	noRating := 0
+	if err := d.EditCollectionInstance(ctx, testUsername, 1, 130076, 2, &InstanceRequest{Rating: &noRating}); err != nil {
+		t.Fatalf("failed to clear rating: %s", err)
+	}
+	if err := d.DeleteCollectionInstance(ctx, testUsername, 1, 130076, instance.InstanceID); err != nil {
+		t.Fatalf("failed to delete instance: %s", err)
+	}
+}
+
+func TestCollectionServiceCollectionInstanceErrors(t *testing.T) {
+	d := initDiscogsClient(t, nil)
+	ctx := context.Background()
+
+	if _, err := d.AddToCollectionFolder(ctx, testUsername, 0, 130076); err != ErrInvalidFolderID {
+		t.Errorf("add err got=%v; want=%s", err, ErrInvalidFolderID)
+	}
+	rating := 6
+	if err := d.EditCollectionInstance(ctx, testUsername, 1, 130076, 1, &InstanceRequest{Rating: &rating}); err != ErrInvalidRating {
+		t.Errorf("edit err got=%v; want=%s", err, ErrInvalidRating)
+	}
+	if err := d.DeleteCollectionInstance(ctx, testUsername, 1, 130076, 0); err != ErrInvalidInstanceID {
+		t.Errorf("delete err got=%v; want=%s", err, ErrInvalidInstanceID)
+	}
+	if err := d.EditCollectionInstance(ctx, testUsername, 0, 130076, 1, &InstanceRequest{FolderID: 2}); err != ErrInvalidFolderID {
+		t.Errorf("edit err got=%v; want=%s", err, ErrInvalidFolderID)
+	}
+	if err := d.DeleteCollectionInstance(ctx, testUsername, 0, 130076, 1); err != ErrInvalidFolderID {
+		t.Errorf("delete err got=%v; want=%s", err, ErrInvalidFolderID)
+	}
+}
+
 func TestCollectionServiceFolder(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(CollectionServer))
 	defer ts.Close()
 
 	d := initDiscogsClient(t, &Options{URL: ts.URL})
 
-	folder, err := d.Folder(testUsername, 0)
+	folder, err := d.Folder(context.Background(), testUsername, 0)
 	if err != nil {
 		t.Fatalf("failed to get folder: %s", err)
 	}
@@ -98,7 +262,7 @@ func TestCollectionServiceCollectionFolders(t *testing.T) {
 
 	d := initDiscogsClient(t, &Options{URL: ts.URL})
 
-	collection, err := d.CollectionFolders(testUsername)
+	collection, err := d.CollectionFolders(context.Background(), testUsername)
 	if err != nil {
 		t.Fatalf("failed to get collection: %s", err)
 	}
@@ -111,13 +275,109 @@ func TestCollectionServiceCollectionFolders(t *testing.T) {
 	compareJson(t, string(json), collectionJson)
 }
 
+func TestCollectionServiceCollectionFields(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(CollectionServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	fields, err := d.CollectionFields(context.Background(), testUsername)
+	if err != nil {
+		t.Fatalf("failed to get collection fields: %s", err)
+	}
+
+	json, err := json.Marshal(fields)
+	if err != nil {
+		t.Fatalf("failed to marshal collection fields: %s", err)
+	}
+
+	compareJson(t, string(json), collectionFieldsJson)
+}
+
+func TestCollectionServiceCollectionValue(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(CollectionServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	value, err := d.CollectionValue(context.Background(), testUsername)
+	if err != nil {
+		t.Fatalf("failed to get collection value: %s", err)
+	}
+
+	if value.Median.Value != 1202.59 || value.Median.Currency != "USD" {
+		t.Errorf("median got=%+v; want 1202.59 USD", value.Median)
+	}
+
+	json, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("failed to marshal collection value: %s", err)
+	}
+
+	compareJson(t, string(json), collectionValueJson)
+}
+
+func TestPriceUnmarshal(t *testing.T) {
+	tests := map[string]Price{
+		`"$1,234.56"`: {Value: 1234.56, Currency: "USD", Raw: "$1,234.56"},
+		`"€12.00"`:    {Value: 12, Currency: "EUR", Raw: "€12.00"},
+		`"CA$5.50"`:   {Value: 5.5, Currency: "CAD", Raw: "CA$5.50"},
+		`"-£3.20"`:    {Value: -3.2, Currency: "GBP", Raw: "-£3.20"},
+		`"100 SEK"`:   {Value: 100, Currency: "SEK", Raw: "100 SEK"},
+		`""`:          {},
+	}
+	for in, want := range tests {
+		var got Price
+		if err := json.Unmarshal([]byte(in), &got); err != nil {
+			t.Errorf("%s: failed to unmarshal: %s", in, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("%s: got=%+v; want=%+v", in, got, want)
+		}
+	}
+}
+
+func TestPriceCurrencies(t *testing.T) {
+	tests := map[Currency]string{
+		CurrencyUSD: "$12.50",
+		CurrencyGBP: "£12.50",
+		CurrencyEUR: "€12.50",
+		CurrencyCAD: "CA$12.50",
+		CurrencyAUD: "A$12.50",
+		CurrencyJPY: "¥12.50",
+		CurrencyCHF: "CHF12.50",
+		CurrencyMXN: "MX$12.50",
+		CurrencyBRL: "R$12.50",
+		CurrencyNZD: "NZ$12.50",
+		CurrencySEK: "SEK12.50",
+		CurrencyZAR: "R12.50",
+		CurrencyDKK: "DKK12.50",
+	}
+	for _, cur := range currencies {
+		raw, ok := tests[cur]
+		if !ok {
+			t.Errorf("%s: no formatted price to test", cur)
+			continue
+		}
+		var got Price
+		if err := json.Unmarshal([]byte(`"`+raw+`"`), &got); err != nil {
+			t.Errorf("%s: failed to unmarshal: %s", raw, err)
+			continue
+		}
+		if want := (Price{Value: 12.5, Currency: cur, Raw: raw}); got != want {
+			t.Errorf("%s: got=%+v; want=%+v", raw, got, want)
+		}
+	}
+}
+
 func TestCollectionServiceCollectionItemsByFolder(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(CollectionServer))
 	defer ts.Close()
 
 	d := initDiscogsClient(t, &Options{URL: ts.URL})
 
-	items, err := d.CollectionItemsByFolder(testUsername, 0, &Pagination{Sort: "artist", SortOrder: "desc", PerPage: 2})
+	items, err := d.CollectionItemsByFolder(context.Background(), testUsername, 0, &Pagination{Sort: "artist", SortOrder: "desc", PerPage: 2})
 
 	if err != nil {
 		t.Fatalf("failed to get collection items: %s", err)
@@ -137,19 +397,92 @@ func TestCollectionServiceCollectionItemsByFolderError(t *testing.T) {
 
 	d := initDiscogsClient(t, &Options{URL: ts.URL})
 
-	_, err := d.CollectionItemsByFolder(testUsername, 0, &Pagination{Sort: "invalid"})
+	_, err := d.CollectionItemsByFolder(context.Background(), testUsername, 0, &Pagination{Sort: "invalid"})
 	if err != ErrInvalidSortKey {
 		t.Fatalf("err got=%s; want=%s", err, ErrInvalidSortKey)
 	}
 }
 
+func CollectionExportServer(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/users/" + testUsername + "/collection/folders":
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, collectionExportFoldersJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	case "/users/" + testUsername + "/collection/folders/0/releases":
+		if r.URL.Query().Get("per_page") != "100" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, collectionExportItemsJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestCollectionServiceExportCollection(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(CollectionExportServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	var csv strings.Builder
+	if err := d.ExportCollection(context.Background(), testUsername, 0, &csv, ExportCSV); err != nil {
+		t.Fatalf("failed to export collection as csv: %s", err)
+	}
+	wantCSV := `Catalog#,Artist,Title,Label,Format,Rating,Released,release_id,CollectionFolder,Date Added,Collection Media Condition,Collection Sleeve Condition,Collection Notes
+PR014,Zoo Lake,Zonk,Permanent Record,"Vinyl, LP, Album",4,2018,12934893,Uncategorized,2020-01-19 14:19:11,Near Mint (NM or M-),,Purple vinyl
+"none, SR1",Various,Untitled,"Not On Label, Self-released","2xCD, Compilation",0,,4825435,Uncategorized,2015-11-08 14:42:02,,,
+`
+	if csv.String() != wantCSV {
+		t.Errorf("csv got=%s; want=%s", csv.String(), wantCSV)
+	}
+
+	var out strings.Builder
+	if err := d.ExportCollection(context.Background(), testUsername, 0, &out, ExportJSON); err != nil {
+		t.Fatalf("failed to export collection as json: %s", err)
+	}
+	var rows []map[string]string
+	if err := json.Unmarshal([]byte(out.String()), &rows); err != nil {
+		t.Fatalf("failed to unmarshal json export: %s", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("rows got=%d; want=2", len(rows))
+	}
+	if rows[0]["Title"] != "Zonk" || rows[0]["Collection Notes"] != "Purple vinyl" || rows[1]["release_id"] != "4825435" {
+		t.Errorf("unexpected json export: %v", rows)
+	}
+}
+
+func TestCollectionServiceExportCollectionErrors(t *testing.T) {
+	d := initDiscogsClient(t, nil)
+
+	if err := d.ExportCollection(context.Background(), testUsername, 0, io.Discard, "xml"); err != ErrInvalidExportFormat {
+		t.Errorf("err got=%s; want=%s", err, ErrInvalidExportFormat)
+	}
+	if err := d.ExportCollection(context.Background(), "", 0, io.Discard, ExportCSV); err != ErrInvalidUsername {
+		t.Errorf("err got=%s; want=%s", err, ErrInvalidUsername)
+	}
+}
+
 func TestCollectionServiceCollectionItemsByRelease(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(CollectionServer))
 	defer ts.Close()
 
 	d := initDiscogsClient(t, &Options{URL: ts.URL})
 
-	items, err := d.CollectionItemsByRelease(testUsername, 12934893)
+	items, err := d.CollectionItemsByRelease(context.Background(), testUsername, 12934893)
 
 	if err != nil {
 		t.Fatalf("failed to get collection items: %s", err)
@@ -190,7 +523,7 @@ func TestCollectionServiceCollectionItemsByReleaseErrors(t *testing.T) {
 	for name, tc := range testCases {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
-			_, err := d.CollectionItemsByRelease(tc.username, tc.releaseID)
+			_, err := d.CollectionItemsByRelease(context.Background(), tc.username, tc.releaseID)
 			if err != tc.err {
 				t.Fatalf("err got=%s; want=%s", err, tc.err)
 			}
@@ -203,9 +536,24 @@ func TestCollectionServiceEditFieldsInstance(t *testing.T) {
 
 	d := initDiscogsClient(t, &Options{URL: ts.URL})
 
-	err := d.EditFieldsInstance(testUsername, 1, 10191384, 313879623, NotesField, "test-value")
+	err := d.EditFieldsInstance(context.Background(), testUsername, 1, 10191384, 313879623, NotesField, "test-value")
 
 	if err != nil {
 		t.Fatalf("failed to edit field for instance: %s", err)
 	}
 }
+
+func TestCollectionServiceEditFieldsInstanceErrors(t *testing.T) {
+	d := initDiscogsClient(t, nil)
+	ctx := context.Background()
+
+	if err := d.EditFieldsInstance(ctx, testUsername, 1, 10191384, 0, NotesField, "test-value"); err != ErrInvalidInstanceID {
+		t.Errorf("err got=%v; want=%s", err, ErrInvalidInstanceID)
+	}
+	if err := d.EditFieldsInstance(ctx, testUsername, 0, 10191384, 313879623, NotesField, "test-value"); err != ErrInvalidFolderID {
+		t.Errorf("err got=%v; want=%s", err, ErrInvalidFolderID)
+	}
+	if err := d.EditFieldsInstance(ctx, testUsername, 1, 10191384, 313879623, 0, "test-value"); err != ErrInvalidFieldID {
+		t.Errorf("err got=%v; want=%s", err, ErrInvalidFieldID)
+	}
+}
@@ -0,0 +1,199 @@
+package discogs
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func UserServer(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/oauth/identity":
+		if r.Method != "GET" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, identityJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+	case "/users/" + testUsername + "/submissions":
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, submissionsJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+	case "/users/" + testUsername + "/contributions":
+		if r.URL.Query().Get("sort") != "year" || r.URL.Query().Get("sort_order") != "desc" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, contributionsJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+	case "/users/" + testUsername:
+		switch r.Method {
+		case "GET":
+		case "POST":
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				return
+			}
+			if body["username"] != testUsername || body["location"] != "Portland, Oregon" || body["curr_abbr"] != "USD" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				return
+			}
+			if _, ok := body["name"]; ok {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				return
+			}
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, profileJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestUserServiceIdentity(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(UserServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	identity, err := d.Identity(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get identity: %s", err)
+	}
+
+	json, err := json.Marshal(identity)
+	if err != nil {
+		t.Fatalf("failed to marshal identity: %s", err)
+	}
+
+	compareJson(t, string(json), identityJson)
+}
+
+func TestUserServiceProfile(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(UserServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	profile, err := d.Profile(context.Background(), testUsername)
+	if err != nil {
+		t.Fatalf("failed to get profile: %s", err)
+	}
+
+	json, err := json.Marshal(profile)
+	if err != nil {
+		t.Fatalf("failed to marshal profile: %s", err)
+	}
+
+	compareJson(t, string(json), profileJson)
+}
+
+func TestUserServiceEditProfile(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(UserServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	profile, err := d.EditProfile(context.Background(), testUsername, &ProfileRequest{Location: "Portland, Oregon", Currency: "USD"})
+	if err != nil {
+		t.Fatalf("failed to edit profile: %s", err)
+	}
+	if profile.Location != "Portland, Oregon" {
+		t.Errorf("location got=%s; want=%s", profile.Location, "Portland, Oregon")
+	}
+
+	if _, err := d.EditProfile(context.Background(), testUsername, &ProfileRequest{Currency: "RUR"}); err != ErrCurrencyNotSupported {
+		t.Errorf("err got=%v; want=%s", err, ErrCurrencyNotSupported)
+	}
+}
+
+func TestUserServiceSubmissions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(UserServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	submissions, err := d.Submissions(context.Background(), testUsername, nil)
+	if err != nil {
+		t.Fatalf("failed to get submissions: %s", err)
+	}
+
+	items := submissions.Submissions
+	if len(items.Artists) != 1 || items.Artists[0].Name != "Chuck Carr" {
+		t.Errorf("artists got=%+v", items.Artists)
+	}
+	if len(items.Labels) != 1 || items.Labels[0].Name != "Stone Age Records" {
+		t.Errorf("labels got=%+v", items.Labels)
+	}
+	if len(items.Releases) != 1 || items.Releases[0].ID != 1010 {
+		t.Errorf("releases got=%+v", items.Releases)
+	}
+
+	var pages []SubmissionItems
+	err = d.SubmissionsPager(testUsername, &Pagination{PerPage: 50}).ForEach(context.Background(), func(items SubmissionItems) error {
+		pages = append(pages, items)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to iterate submissions: %s", err)
+	}
+	if len(pages) != 1 || len(pages[0].Artists) != 1 || pages[0].Artists[0].Name != "Chuck Carr" {
+		t.Errorf("submissions pages got=%+v", pages)
+	}
+}
+
+func TestUserServiceSubmissionsSortErrors(t *testing.T) {
+	d := initDiscogsClient(t, nil)
+	ctx := context.Background()
+
+	if _, err := d.Submissions(ctx, testUsername, &Pagination{Sort: SortByYear}); err != ErrInvalidSortKey {
+		t.Errorf("err got=%v; want=%s", err, ErrInvalidSortKey)
+	}
+	if _, err := d.SubmissionsPager(testUsername, &Pagination{SortOrder: SortDesc, Sort: SortByTitle}).Next(ctx); err != ErrInvalidSortKey {
+		t.Errorf("pager err got=%v; want=%s", err, ErrInvalidSortKey)
+	}
+	if _, err := d.Submissions(ctx, testUsername, &Pagination{SortOrder: "up"}); err != ErrInvalidSortOrder {
+		t.Errorf("err got=%v; want=%s", err, ErrInvalidSortOrder)
+	}
+}
+
+func TestUserServiceContributions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(UserServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	contributions, err := d.Contributions(context.Background(), testUsername, &Pagination{Sort: "year", SortOrder: "desc"})
+	if err != nil {
+		t.Fatalf("failed to get contributions: %s", err)
+	}
+	if len(contributions.Contributions) != 1 || contributions.Contributions[0].Title != "Shima Eco Tones" {
+		t.Errorf("contributions got=%+v", contributions.Contributions)
+	}
+
+	if _, err := d.Contributions(context.Background(), testUsername, &Pagination{Sort: "invalid"}); err != ErrInvalidSortKey {
+		t.Errorf("err got=%v; want=%s", err, ErrInvalidSortKey)
+	}
+}
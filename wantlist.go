@@ -0,0 +1,126 @@
+package discogs
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// WantlistService is an interface to work with wantlist.
+type WantlistService interface {
+	// Wantlist returns the list of releases in a user’s wantlist.
+	// Authentication is required if the wantlist is private.
+	Wantlist(ctx context.Context, username string, pagination *Pagination) (*Wantlist, error)
+	// WantlistPager iterates over all pages of a user’s wantlist.
+	WantlistPager(username string, pagination *Pagination) *Pager[Want]
+	// AddToWantlist adds a release to a user’s wantlist.
+	// Authentication as the wantlist owner is required.
+	AddToWantlist(ctx context.Context, username string, releaseID int, want *WantRequest) (*Want, error)
+	// EditWant changes notes or rating of a release in a user’s wantlist.
+	// Authentication as the wantlist owner is required.
+	EditWant(ctx context.Context, username string, releaseID int, want *WantRequest) (*Want, error)
+	// DeleteFromWantlist removes a release from a user’s wantlist.
+	// Authentication as the wantlist owner is required.
+	DeleteFromWantlist(ctx context.Context, username string, releaseID int) error
+}
+
+type wantlistService struct {
+	*client
+	url string
+}
+
+func newWantlistService(c *client, url string) WantlistService {
+	return &wantlistService{
+		client: c,
+		url:    url,
+	}
+}
+
+// Want is a release in a user’s wantlist.
+type Want struct {
+	ID               int              `json:"id"`
+	BasicInformation BasicInformation `json:"basic_information"`
+	DateAdded        string           `json:"date_added,omitempty"`
+	Notes            string           `json:"notes"`
+	Rating           int              `json:"rating"`
+	ResourceURL      string           `json:"resource_url"`
+}
+
+// Wantlist is a list of releases in a user’s wantlist.
+type Wantlist struct {
+	Pagination Page   `json:"pagination"`
+	Wants      []Want `json:"wants"`
+}
+
+// WantRequest describes a release added to or edited in a wantlist.
+type WantRequest struct {
+	Notes  string // user notes to associate with the release (optional)
+	Rating int    // rating between 0 and 5 (optional)
+}
+
+func (r *WantRequest) params() (url.Values, error) {
+	params := url.Values{}
+	if r == nil {
+		return params, nil
+	}
+	if r.Rating < 0 || r.Rating > 5 {
+		return nil, ErrInvalidRating
+	}
+	if r.Notes != "" {
+		params.Set("notes", r.Notes)
+	}
+	if r.Rating != 0 {
+		params.Set("rating", strconv.Itoa(r.Rating))
+	}
+	return params, nil
+}
+
+func (s *wantlistService) Wantlist(ctx context.Context, username string, pagination *Pagination) (*Wantlist, error) {
+	if username == "" {
+		return nil, ErrInvalidUsername
+	}
+	var wantlist *Wantlist
+	err := s.request(ctx, s.url+"/"+username+"/wants", pagination.params(), &wantlist)
+	return wantlist, err
+}
+
+func (s *wantlistService) WantlistPager(username string, pagination *Pagination) *Pager[Want] {
+	if username == "" {
+		return errPager[Want](ErrInvalidUsername)
+	}
+	return newPager[Want](s.client, s.url+"/"+username+"/wants", pagination.params(), "wants")
+}
+
+func (s *wantlistService) AddToWantlist(ctx context.Context, username string, releaseID int, want *WantRequest) (*Want, error) {
+	return s.editWant(ctx, "PUT", username, releaseID, want)
+}
+
+func (s *wantlistService) EditWant(ctx context.Context, username string, releaseID int, want *WantRequest) (*Want, error) {
+	return s.editWant(ctx, "POST", username, releaseID, want)
+}
+
+func (s *wantlistService) editWant(ctx context.Context, method string, username string, releaseID int, want *WantRequest) (*Want, error) {
+	if username == "" {
+		return nil, ErrInvalidUsername
+	}
+	if releaseID == 0 {
+		return nil, ErrInvalidReleaseID
+	}
+	params, err := want.params()
+	if err != nil {
+		return nil, err
+	}
+	var w *Want
+	err = s.requestWithMethod(ctx, method, s.url+"/"+username+"/wants/"+strconv.Itoa(releaseID), params, &w)
+	return w, err
+}
+
+func (s *wantlistService) DeleteFromWantlist(ctx context.Context, username string, releaseID int) error {
+	if username == "" {
+		return ErrInvalidUsername
+	}
+	if releaseID == 0 {
+		return ErrInvalidReleaseID
+	}
+	return s.requestWithMethod(ctx, "DELETE", s.url+"/"+username+"/wants/"+strconv.Itoa(releaseID), nil, nil)
+}
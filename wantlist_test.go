@@ -0,0 +1,121 @@
+package discogs
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testWantReleaseID = 1867708
+
+func WantlistServer(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/users/" + testUsername + "/wants":
+		if r.Method != "GET" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.WriteString(w, wantlistJson); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+	case "/users/" + testUsername + "/wants/1867708":
+		switch r.Method {
+		case "PUT", "POST":
+			if r.URL.Query().Get("notes") != "Pressing with the blue label" || r.URL.Query().Get("rating") != "4" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				return
+			}
+			if r.Method == "PUT" {
+				w.WriteHeader(http.StatusCreated)
+			} else {
+				w.WriteHeader(http.StatusOK)
+			}
+			if _, err := io.WriteString(w, wantJson); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestWantlistServiceWantlist(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(WantlistServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+
+	wantlist, err := d.Wantlist(context.Background(), testUsername, nil)
+	if err != nil {
+		t.Fatalf("failed to get wantlist: %s", err)
+	}
+
+	json, err := json.Marshal(wantlist)
+	if err != nil {
+		t.Fatalf("failed to marshal wantlist: %s", err)
+	}
+
+	compareJson(t, string(json), wantlistJson)
+}
+
+func TestWantlistServiceEditWant(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(WantlistServer))
+	defer ts.Close()
+
+	d := initDiscogsClient(t, &Options{URL: ts.URL})
+	want := &WantRequest{Notes: "Pressing with the blue label", Rating: 4}
+
+	added, err := d.AddToWantlist(context.Background(), testUsername, testWantReleaseID, want)
+	if err != nil {
+		t.Fatalf("failed to add to wantlist: %s", err)
+	}
+	json, err := json.Marshal(added)
+	if err != nil {
+		t.Fatalf("failed to marshal want: %s", err)
+	}
+	compareJson(t, string(json), wantJson)
+
+	if _, err := d.EditWant(context.Background(), testUsername, testWantReleaseID, want); err != nil {
+		t.Fatalf("failed to edit want: %s", err)
+	}
+
+	if err := d.DeleteFromWantlist(context.Background(), testUsername, testWantReleaseID); err != nil {
+		t.Fatalf("failed to delete from wantlist: %s", err)
+	}
+}
+
+func TestWantlistServiceErrors(t *testing.T) {
+	d := initDiscogsClient(t, nil)
+
+	tests := map[string]struct {
+		username  string
+		releaseID int
+		want      *WantRequest
+		err       error
+	}{
+		"invalid username":   {username: "", releaseID: 1, err: ErrInvalidUsername},
+		"invalid release id": {username: testUsername, releaseID: 0, err: ErrInvalidReleaseID},
+		"invalid rating":     {username: testUsername, releaseID: 1, want: &WantRequest{Rating: 6}, err: ErrInvalidRating},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			if _, err := d.AddToWantlist(context.Background(), tt.username, tt.releaseID, tt.want); err != tt.err {
+				t.Fatalf("err got=%v; want=%s", err, tt.err)
+			}
+		})
+	}
+}